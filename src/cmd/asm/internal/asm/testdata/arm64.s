@@ -136,6 +136,18 @@ TEXT	foo(SB), DUPOK|NOSPLIT, $-8
 	MOVD	$1, ZR
 	MOVD	$1, R1
 	MOVD	ZR, (R1)
+
+// large constants are built with MOVZ or MOVN and the fewest MOVKs,
+// skipping 16-bit chunks that are all 0s or all 1s.
+	MOVD	$0x12345678, R1         // MOVD	$305419896, R1          // 01cf8ad28146a2f2
+	MOVD	$0x1234000000005678, R2 // MOVD	$1311673391471679096, R2 // 02cf8ad28246e2f2
+	MOVD	$-0xedcba988, R3        // MOVD	$-3989547400, R3        // e33095928346a2f2
+	MOVD	$0x1234567800009abc, R4 // MOVD	$1311768464867760828, R4 // 845793d204cfcaf28446e2f2
+	MOVD	$-0xedcba9876544, R6    // MOVD	$-261458978366788, R6   // 66a88c9206cfaaf28646c2f2
+	MOVD	$0x123456789abcdef0, R5 // MOVD	$1311768467463790320, R5 // 05de9bd28557b3f205cfcaf28546e2f2
+// bitmask immediates are a single ORR from ZR; one bit off is not.
+	MOVD	$0x5555555555555555, R7 // MOVD	$6148914691236517205, R7 // e7f300b2
+	MOVD	$0x5555555555555554, R8 // MOVD	$6148914691236517204, R8 // 88aa8ad2a8aaaaf2a8aacaf2a8aaeaf2
 	VLD1	(R8), [V1.B16, V2.B16]                          // 01a1404c
 	VLD1.P	(R3), [V31.H8, V0.H8]                           // 7fa4df4c
 	VLD1.P	(R8)(R20), [V21.B16, V22.B16]                   // VLD1.P	(R8)(R20*1), [V21.B16,V22.B16] // 15a1d44c
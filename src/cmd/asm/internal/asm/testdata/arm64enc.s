@@ -395,6 +395,8 @@ TEXT asmtest(SB),DUPOK|NOSPLIT,$-8
    WFI                                        // 7f2003d5
    YIELD                                      // 3f2003d5
    //TODO FADD V21.D2, V10.D2, V21.D2         // 55d5754e
+   FABDS F12, F2, F10                         // 4ad4ac7e
+   FABDD F24, F14, F12                        // ccd5f87e
    FADDS F12, F2, F10                         // 4a282c1e
    FADDD F24, F14, F12                        // cc29781e
    FCCMPS LE, F17, F12, $14                   // 8ed5311e
@@ -98,4 +98,10 @@ TEXT asmtest(SB),DUPOK|NOSPLIT,$0
 	LDAR (R4),$0,R5                 // 7ca020a8
 	LDAR (R3),R5                    // 7ca018a8
 
+	// byte-reversed load and store
+	MOVWBR (R4), R3                 // 7c60242c
+	MOVWBR R3, (R4)                 // 7c60252c
+	MOVDBR (R4), R3                 // 7c602428
+	MOVDBR R3, (R4)                 // 7c602528
+
 	RET
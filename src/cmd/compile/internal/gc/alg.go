@@ -530,6 +530,31 @@ func eqmem(p *Node, q *Node, field *types.Sym, size int64) *Node {
 	return call
 }
 
+// eqvec returns the node
+// 	memequal128(&p[0], &q[0]) && memequal128(&p[1], &q[1]) ...
+// comparing the size bytes at p and q as arrays of [16]byte.
+// memequal128 is intrinsified on amd64 to use SSE.
+func eqvec(p *Node, q *Node, size int64) *Node {
+	b16 := types.NewArray(types.Types[TUINT8], 16)
+	pt := types.NewPtr(types.NewArray(b16, size/16))
+	var res *Node
+	for i := int64(0); i < size/16; i++ {
+		nx := nod(OINDEX, conv(conv(p, types.Types[TUNSAFEPTR]), pt), nodintconst(i))
+		ny := nod(OINDEX, conv(conv(q, types.Types[TUNSAFEPTR]), pt), nodintconst(i))
+		fn := syslook("memequal128")
+		fn = substArgTypes(fn, b16, b16)
+		call := nod(OCALL, fn, nil)
+		call.List.Append(nod(OADDR, nx, nil))
+		call.List.Append(nod(OADDR, ny, nil))
+		if res == nil {
+			res = call
+		} else {
+			res = nod(OANDAND, res, call)
+		}
+	}
+	return res
+}
+
 func eqmemfunc(size int64, t *types.Type) (fn *Node, needsize bool) {
 	switch size {
 	default:
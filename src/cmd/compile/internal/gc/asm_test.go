@@ -6,16 +6,24 @@ package gc
 
 import (
 	"bytes"
+	"cmd/internal/objabi"
+	"context"
+	"flag"
 	"fmt"
 	"internal/testenv"
 	"io/ioutil"
 	"os"
 	"os/exec"
+	"path"
 	"path/filepath"
 	"regexp"
 	"runtime"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 // This file contains code generation tests.
@@ -68,26 +76,90 @@ import (
 // functions contains certain expected instructions.
 func TestAssembly(t *testing.T) {
 	testenv.MustHaveGoBuild(t)
-	if runtime.GOOS == "windows" {
-		// TODO: remove if we can get "go tool compile -S" to work on windows.
-		t.Skipf("skipping test: recursive windows compile not working")
-	}
 	dir, err := ioutil.TempDir("", "TestAssembly")
 	if err != nil {
 		t.Fatalf("could not create directory: %v", err)
 	}
-	defer os.RemoveAll(dir)
+	// The assembly of a failing test is written under GOASMDUMP, or
+	// else under dir, which is then kept. If GOSSADUMP is set, its
+	// ssa.html is generated too, and dir is always kept.
+	ssaDump := os.Getenv("GOSSADUMP") != ""
+	asmDumpDir := os.Getenv("GOASMDUMP")
+	defer func(keepFailures bool) {
+		if ssaDump || keepFailures && t.Failed() {
+			t.Logf("keeping scratch directory %s", dir)
+			return
+		}
+		os.RemoveAll(dir)
+	}(asmDumpDir == "")
+	if asmDumpDir == "" {
+		asmDumpDir = dir
+	}
 
 	nameRegexp := regexp.MustCompile("func \\w+")
 	t.Run("platform", func(t *testing.T) {
 		for _, ats := range allAsmTests {
-			ats := ats
-			t.Run(ats.os+"/"+ats.arch, func(tt *testing.T) {
+			ats := ats.withVariants()
+			t.Run(ats.name(), func(tt *testing.T) {
 				tt.Parallel()
+				if runtime.GOOS == "windows" && len(ats.allImports()) > 0 {
+					// TestLineNumber shows that go tool compile -S
+					// works on windows, but building the imports
+					// for another GOOS has not been made to work.
+					// TODO: remove if we can get it to work.
+					tt.Skipf("skipping test: building imports not working on windows")
+				}
 
 				asm := ats.compileToAsm(tt, dir)
+				testDir := filepath.Join(dir, strings.Replace(ats.name(), "/", "_", -1))
+				// the assembly for other OSes, compiled on demand
+				// in the directory of this test group
+				osAsm := map[string]string{ats.os: asm}
+				asmFor := func(goos string) string {
+					if _, ok := osAsm[goos]; !ok {
+						ots := *ats
+						ots.os = goos
+						osAsm[goos] = ots.compileToAsm(tt, testDir)
+					}
+					return osAsm[goos]
+				}
+				// the assembly from compiling the same file again,
+				// also on demand
+				var againAsm string
+				again := func() string {
+					if againAsm == "" {
+						againAsm = ats.compile(tt, testDir)
+					}
+					return againAsm
+				}
 
 				for i, at := range ats.tests {
+					if at.skip != nil {
+						if reason := at.skip(); reason != "" {
+							tt.Logf("skipping test %d: %s", i, reason)
+							continue
+						}
+					}
+					// a test with flags of its own is compiled alone,
+					// in a subdirectory of the group's directory
+					ats, i, asm, dir, asmFor, again := ats, i, asm, dir, asmFor, again
+					if len(at.flags) > 0 {
+						dir = filepath.Join(testDir, fmt.Sprintf("alone%d", i))
+						if err := os.Mkdir(dir, 0700); err != nil {
+							tt.Fatalf("could not create directory: %v", err)
+						}
+						ats, i = ats.alone(i), 0
+						asm = ats.compileToAsm(tt, dir)
+						ats, dir := ats, dir
+						asmFor = func(goos string) string {
+							ots := *ats
+							ots.os = goos
+							return ots.compileToAsm(tt, dir)
+						}
+						again = func() string {
+							return ats.compile(tt, filepath.Join(dir, strings.Replace(ats.name(), "/", "_", -1)))
+						}
+					}
 					var funcName string
 					if strings.Contains(at.fn, "func $") {
 						funcName = fmt.Sprintf("f%d_%s", i, ats.arch)
@@ -95,8 +167,54 @@ func TestAssembly(t *testing.T) {
 						funcName = nameRegexp.FindString(at.fn)[len("func "):]
 					}
 					fa := funcAsm(tt, asm, funcName)
-					if fa != "" {
-						at.verifyAsm(tt, fa)
+					if fa != "" && at.closures {
+						fa += closuresAsm(tt, asm, funcName)
+					}
+					if fa == "" {
+						continue
+					}
+					if ats.sLevel > 1 {
+						fa += "\n" + ssaAsm(asm, funcName)
+					}
+					failures := at.verifyAsm(tt, ats.arch, fa)
+					if len(at.inlined) > 0 {
+						failures = append(failures, at.verifyInlined(tt, asm, fa, ats.funcNames(i, at.inlined))...)
+					}
+					for _, goos := range at.sameAsmOS {
+						if ofa := funcAsm(tt, asmFor(goos), funcName); ofa != "" {
+							failures = append(failures, at.verifySameAsm(tt, ats.arch, goos, fa, ofa)...)
+						}
+					}
+					if at.deterministic {
+						againAsm := again()
+						afa := funcAsm(tt, againAsm, funcName)
+						if afa != "" && at.closures {
+							afa += closuresAsm(tt, againAsm, funcName)
+						}
+						if afa != "" && ats.sLevel > 1 {
+							afa += "\n" + ssaAsm(againAsm, funcName)
+						}
+						failures = append(failures, at.verifyDeterministic(tt, fa, afa)...)
+					}
+					if len(failures) > 0 {
+						ats.dumpAsm(tt, asmDumpDir, funcName, failures, fa)
+						if ssaDump {
+							ats.dumpSSA(tt, dir, funcName)
+						}
+					}
+					for _, name := range at.otherFuncs() {
+						funcName := ats.funcName(i, name)
+						fa := funcAsm(tt, asm, funcName)
+						if fa == "" {
+							continue
+						}
+						ft := asmTest{fn: at.fn, pos: at.funcPos[name], neg: at.funcNeg[name]}
+						if failures := ft.verifyAsm(tt, ats.arch, fa); len(failures) > 0 {
+							ats.dumpAsm(tt, asmDumpDir, funcName, failures, fa)
+							if ssaDump {
+								ats.dumpSSA(tt, dir, funcName)
+							}
+						}
 					}
 				}
 			})
@@ -106,12 +224,32 @@ func TestAssembly(t *testing.T) {
 
 var nextTextRegexp = regexp.MustCompile(`\n\S`)
 
-// funcAsm returns the assembly listing for the given function name.
+// funcAsm returns the assembly listing for the given function name,
+// including the encoding and relocations that follow the instructions.
+// If there is none, it fails the test, listing the functions the
+// assembly does have, and returns "".
+//
+// The listing is looked for after the line naming the function's
+// symbol, as in `"".f STEXT size=44 args=0x20 locals=0x0`, so that the
+// TEXT lines of the annotated listings printed by -S=2 are not mistaken
+// for it.
 func funcAsm(t *testing.T, asm string, funcName string) string {
-	if i := strings.Index(asm, fmt.Sprintf("TEXT\t\"\".%s(SB)", funcName)); i >= 0 {
-		asm = asm[i:]
+	header := regexp.MustCompile(`(?m)^"".` + regexp.QuoteMeta(funcName) + ` STEXT .*\n`)
+	text := fmt.Sprintf("TEXT\t\"\".%s(SB)", funcName)
+	if loc := header.FindStringIndex(asm); loc != nil && strings.Contains(asm[loc[1]:], text) {
+		asm = asm[loc[1]:]
+		asm = asm[strings.Index(asm, text):]
 	} else {
-		t.Errorf("could not find assembly for function %v", funcName)
+		seen := make(map[string]bool)
+		var names []string
+		for _, m := range textNameRegexp.FindAllStringSubmatch(asm, -1) {
+			if !seen[m[1]] {
+				seen[m[1]] = true
+				names = append(names, m[1])
+			}
+		}
+		sort.Strings(names)
+		t.Errorf("could not find assembly for function %v, so it was not checked; found functions %s", funcName, strings.Join(names, ", "))
 		return ""
 	}
 
@@ -124,55 +262,901 @@ func funcAsm(t *testing.T, asm string, funcName string) string {
 	return asm
 }
 
+var relocRegexp = regexp.MustCompile(`(?m)^\trel .* t=(\d+) .*$`)
+
+// funcRelocs returns the relocation lines of the assembly listing fa,
+// with the relocation types written by name.
+func funcRelocs(fa string) []string {
+	var relocs []string
+	for _, m := range relocRegexp.FindAllStringSubmatchIndex(fa, -1) {
+		typ, _ := strconv.Atoi(fa[m[2]:m[3]])
+		relocs = append(relocs, fa[m[0]+1:m[2]]+objabi.RelocType(typ).String()+fa[m[3]:m[1]])
+	}
+	return relocs
+}
+
+// ssaAsmEndRegexp matches the end of an annotated listing printed by
+// -S=2: the start of a line that is neither an instruction nor a file
+// name.
+var ssaAsmEndRegexp = regexp.MustCompile(`\n[^ \t#]`)
+
+// ssaAsm returns the annotated listing that -S=2 prints for the given
+// function name, or "" if there is none.
+func ssaAsm(asm string, funcName string) string {
+	i := strings.Index(asm, "genssa "+funcName+"\n")
+	if i < 0 || i > 0 && asm[i-1] != '\n' {
+		return ""
+	}
+	asm = asm[i:]
+	if loc := ssaAsmEndRegexp.FindStringIndex(asm); loc != nil {
+		asm = asm[:loc[0]]
+	}
+	return asm
+}
+
+// closuresAsm returns the assembly listings of the closures defined
+// in the given function.
+func closuresAsm(t *testing.T, asm string, funcName string) string {
+	var fa string
+	for n := 1; strings.Contains(asm, fmt.Sprintf("TEXT\t\"\".%s.func%d(SB)", funcName, n)); n++ {
+		// funcAsm drops the newline ending each listing
+		fa += "\n" + funcAsm(t, asm, fmt.Sprintf("%s.func%d", funcName, n))
+	}
+	return fa
+}
+
+// otherFuncs returns the sorted names of the functions other than the
+// main one that the test has expectations for.
+func (at asmTest) otherFuncs() []string {
+	var names []string
+	for name := range at.funcPos {
+		names = append(names, name)
+	}
+	for name := range at.funcNeg {
+		if _, ok := at.funcPos[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
 type asmTest struct {
 	// function to compile
 	fn string
+	// packages fn imports, besides the imports of its asmTests
+	imports []string
+	// package-level declarations fn uses, written once per file
+	// however many tests in the array give the same decls
+	decls string
 	// regular expressions that must match the generated assembly
 	pos []string
 	// regular expressions that must not match the generated assembly
 	neg []string
+	// regular expressions that must match the generated assembly,
+	// each after the match of the one before it
+	posOrdered []string
+	// regular expressions and the number of times they must match
+	// the generated assembly
+	counts map[string]int
+	// regular expressions and the largest number of times they may
+	// match the generated assembly
+	maxCounts map[string]int
+	// regular expressions that must and must not match the generated
+	// assembly of other functions defined in fn, keyed by name
+	funcPos, funcNeg map[string][]string
+	// other functions defined in fn, by name, that must be inlined
+	// into it
+	inlined []string
+	// maximum number of distinct general purpose and floating point
+	// registers the generated assembly may use; 0 means no limit
+	maxGPRs, maxFPRs int
+	// compile fn as //go:nosplit and check it has no stack check
+	nosplit bool
+	// functions, such as "runtime.mapaccess1", that must not be called;
+	// unlike neg regexps, these can be shared between architectures
+	negCalls []string
+	// check that no bounds check is left, see boundsPanics
+	noBCE bool
+	// maximum number of instructions spent on the stack check, frame
+	// setup and teardown and returns; 0 means no limit. Only the
+	// architectures in asmFrame support it.
+	maxFrameInsts int
+	// check no move copies the register written by the move right
+	// before it, see copyChain; only the architectures in asmMoves
+	// support it
+	noCopyChains bool
+	// extra flags for go tool compile, such as -N; fn is then
+	// compiled on its own, which costs a few more go commands, so
+	// flags that many tests need are better set on the asmTests
+	flags []string
+	// configurations to run the test in, see withVariants
+	variants []asmVariant
+	// also check the assembly of the closures defined in fn
+	closures bool
+	// name of a file in testdata/asm the whole listing must match,
+	// see goldenAsm; go test -run TestAssembly -update rewrites it
+	golden string
+	// if not nil, returns why the expectations do not hold for the
+	// toolchain under test, such as an experiment it was built with;
+	// the test is then skipped
+	skip func() string
+	// check the stack check comes first, before the frame setup
+	// or anything else that may write to the stack; only the
+	// architectures in asmFrame support it
+	stackCheckFirst bool
+	// frame and argument sizes in the TEXT line, as in $frame-args;
+	// checked unless both are 0
+	frameSize, argSize int
+	// other GOOS values for which fn must compile to the same
+	// assembly, past the stack check and frame setup; only the
+	// architectures in asmFrame support it
+	sameAsmOS []string
+	// compile fn twice and check the listings are identical
+	deterministic bool
+	// regular expressions that must match the relocations of the
+	// generated assembly, with their types written by name
+	relocs []string
+}
+
+// verifySameAsm checks that the assembly fa of the test's function and
+// its assembly ofa when compiled for goos are the same past the stack
+// check and frame setup, and returns the first line of the error
+// reported if they are not.
+func (at asmTest) verifySameAsm(t *testing.T, arch, goos, fa, ofa string) []string {
+	body, ok := asmBody(fa, arch)
+	obody, _ := asmBody(ofa, arch)
+	var msg string
+	switch {
+	case !ok:
+		msg = fmt.Sprintf("frame instructions are not known for %s\n", arch)
+	case len(body) != len(obody):
+		msg = fmt.Sprintf("expected the same assembly on %s past the prologue, got %d instructions instead of %d\ngo:%s\nasm:%s\n%s asm:%s\n", goos, len(obody), len(body), at.fn, fa, goos, ofa)
+	default:
+		for i := range body {
+			if body[i] != obody[i] {
+				msg = fmt.Sprintf("expected the same assembly on %s past the prologue, got %q instead of %q\ngo:%s\nasm:%s\n%s asm:%s\n", goos, obody[i], body[i], at.fn, fa, goos, ofa)
+				break
+			}
+		}
+	}
+	if msg == "" {
+		return nil
+	}
+	t.Helper()
+	t.Error(msg)
+	return []string{strings.SplitN(msg, "\n", 2)[0]}
+}
+
+// verifyInlined checks that the assembly fa of the test's function
+// does not call the functions named callees, which must be defined in
+// the whole assembly asm, and returns the first line of the error
+// reported for each callee that is not inlined.
+func (at asmTest) verifyInlined(t *testing.T, asm, fa string, callees []string) []string {
+	t.Helper()
+	var failures []string
+	for _, callee := range callees {
+		var msg string
+		if !strings.Contains(asm, fmt.Sprintf("TEXT\t\"\".%s(SB)", callee)) {
+			msg = fmt.Sprintf("expected %s to be inlined, but it is not defined\ngo:%s\n", callee, at.fn)
+		} else if strings.Contains(fa, fmt.Sprintf("\tCALL\t\"\".%s(SB)", callee)) {
+			msg = fmt.Sprintf("expected %s to be inlined, but it is called\ngo:%s\nasm:%s\n", callee, at.fn, fa)
+		}
+		if msg != "" {
+			t.Error(msg)
+			failures = append(failures, strings.SplitN(msg, "\n", 2)[0])
+		}
+	}
+	return failures
+}
+
+var update = flag.Bool("update", false, "update the golden files of TestAssembly")
+
+// verifyDeterministic checks that the listings fa and afa of the test's
+// function from two compilations are identical, and returns the first
+// line of the error reported if they are not.
+func (at asmTest) verifyDeterministic(t *testing.T, fa, afa string) []string {
+	lines, alines := strings.Split(fa, "\n"), strings.Split(afa, "\n")
+	for i := 0; i < len(lines) || i < len(alines); i++ {
+		var l, al string
+		if i < len(lines) {
+			l = lines[i]
+		}
+		if i < len(alines) {
+			al = alines[i]
+		}
+		if l != al {
+			msg := fmt.Sprintf("expected the same assembly when compiled again, line %d is %q instead of %q\ngo:%s\nasm:%s\nagain asm:%s\n", i+1, al, l, at.fn, fa, afa)
+			t.Helper()
+			t.Error(msg)
+			return []string{strings.SplitN(msg, "\n", 2)[0]}
+		}
+	}
+	return nil
 }
 
-func (at asmTest) verifyAsm(t *testing.T, fa string) {
+// verifyAsm checks the assembly fa of the test's function and returns
+// the first line of the error reported for each expectation it did not
+// meet, which names the expectation.
+func (at asmTest) verifyAsm(t *testing.T, arch, fa string) []string {
+	var failures []string
+	errorf := func(format string, args ...interface{}) {
+		t.Helper()
+		msg := fmt.Sprintf(format, args...)
+		if len(at.flags) > 0 {
+			msg = fmt.Sprintf("with flags %s: %s", strings.Join(at.flags, " "), msg)
+		}
+		t.Error(msg)
+		failures = append(failures, strings.SplitN(msg, "\n", 2)[0])
+	}
 	for _, r := range at.pos {
 		if b, err := regexp.MatchString(r, fa); !b || err != nil {
-			t.Errorf("expected:%s\ngo:%s\nasm:%s\n", r, at.fn, fa)
+			errorf("expected:%s\ngo:%s\nasm:%s\n", r, at.fn, fa)
 		}
 	}
 	for _, r := range at.neg {
 		if b, err := regexp.MatchString(r, fa); b || err != nil {
-			t.Errorf("not expected:%s\ngo:%s\nasm:%s\n", r, at.fn, fa)
+			errorf("not expected:%s\ngo:%s\nasm:%s\n", r, at.fn, fa)
+		}
+	}
+	off := 0 // end of the match of the previous ordered regexp
+	for i, r := range at.posOrdered {
+		re, err := regexp.Compile(r)
+		if err != nil {
+			errorf("bad regexp %s: %v\n", r, err)
+			break
+		}
+		loc := re.FindStringIndex(fa[off:])
+		if loc == nil {
+			if i == 0 {
+				errorf("expected:%s\ngo:%s\nasm:%s\n", r, at.fn, fa)
+			} else if loc := re.FindStringIndex(fa); loc != nil {
+				errorf("expected %s after %s, which matched up to offset %d, but it only matched at offset %d\ngo:%s\nasm:%s\n", r, at.posOrdered[i-1], off, loc[0], at.fn, fa)
+			} else {
+				errorf("expected %s after %s, which matched up to offset %d\ngo:%s\nasm:%s\n", r, at.posOrdered[i-1], off, at.fn, fa)
+			}
+			break
+		}
+		off += loc[1]
+	}
+	var counts []string
+	for r := range at.counts {
+		counts = append(counts, r)
+	}
+	sort.Strings(counts)
+	for _, r := range counts {
+		re, err := regexp.Compile(r)
+		if err != nil {
+			errorf("bad regexp %s: %v\n", r, err)
+			continue
+		}
+		if n := len(re.FindAllString(fa, -1)); n != at.counts[r] {
+			errorf("expected %d matches of %s, got %d\ngo:%s\nasm:%s\n", at.counts[r], r, n, at.fn, fa)
+		}
+	}
+	var maxCounts []string
+	for r := range at.maxCounts {
+		maxCounts = append(maxCounts, r)
+	}
+	sort.Strings(maxCounts)
+	for _, r := range maxCounts {
+		re, err := regexp.Compile(r)
+		if err != nil {
+			errorf("bad regexp %s: %v\n", r, err)
+			continue
+		}
+		if n := len(re.FindAllString(fa, -1)); n > at.maxCounts[r] {
+			errorf("expected at most %d matches of %s, got %d\ngo:%s\nasm:%s\n", at.maxCounts[r], r, n, at.fn, fa)
+		}
+	}
+	negCalls := at.negCalls
+	if at.noBCE {
+		negCalls = append(negCalls[:len(negCalls):len(negCalls)], boundsPanics...)
+	}
+	for _, fn := range negCalls {
+		if b, _ := regexp.MatchString(`\tCALL\t`+regexp.QuoteMeta(fn)+`\(SB\)`, fa); b {
+			errorf("unexpected call to %s\ngo:%s\nasm:%s\n", fn, at.fn, fa)
+		}
+	}
+	if len(at.relocs) > 0 {
+		relocs := strings.Join(funcRelocs(fa), "\n")
+		for _, r := range at.relocs {
+			if b, err := regexp.MatchString(r, relocs); !b || err != nil {
+				errorf("expected relocation:%s\ngo:%s\nrelocs:\n%s\nasm:%s\n", r, at.fn, relocs, fa)
+			}
+		}
+	}
+	if at.maxGPRs > 0 {
+		if regs := funcRegs(fa, asmRegs[arch].gp); len(regs) > at.maxGPRs {
+			errorf("expected at most %d general purpose registers, used %d %v\ngo:%s\nasm:%s\n", at.maxGPRs, len(regs), regs, at.fn, fa)
+		}
+	}
+	if at.maxFPRs > 0 {
+		if regs := funcRegs(fa, asmRegs[arch].fp); len(regs) > at.maxFPRs {
+			errorf("expected at most %d floating point registers, used %d %v\ngo:%s\nasm:%s\n", at.maxFPRs, len(regs), regs, at.fn, fa)
+		}
+	}
+	if at.maxFrameInsts > 0 {
+		if n, ok := frameInsts(fa, arch); !ok {
+			errorf("frame instructions are not known for %s\n", arch)
+		} else if n > at.maxFrameInsts {
+			errorf("expected at most %d frame instructions, used %d\ngo:%s\nasm:%s\n", at.maxFrameInsts, n, at.fn, fa)
+		}
+	}
+	if at.noCopyChains {
+		if chain, ok := copyChain(fa, arch); !ok {
+			errorf("move instructions are not known for %s\n", arch)
+		} else if chain != "" {
+			errorf("unexpected chain of moves %q\ngo:%s\nasm:%s\n", chain, at.fn, fa)
+		}
+	}
+	if at.frameSize != 0 || at.argSize != 0 {
+		if frame, args, ok := textSizes(fa); !ok {
+			errorf("no frame and argument sizes in TEXT line\ngo:%s\nasm:%s\n", at.fn, fa)
+		} else if frame != at.frameSize || args != at.argSize {
+			errorf("expected frame size %d and argument size %d, got $%d-%d\ngo:%s\nasm:%s\n", at.frameSize, at.argSize, frame, args, at.fn, fa)
+		}
+	}
+	if at.stackCheckFirst {
+		if inst, ok := stackCheckFirst(fa, arch); !ok {
+			errorf("stack check instructions are not known for %s\n", arch)
+		} else if inst != "" {
+			errorf("expected the stack check first, found %q\ngo:%s\nasm:%s\n", inst, at.fn, fa)
+		}
+	}
+	if at.nosplit {
+		m := textRegexp.FindStringSubmatch(fa)
+		switch {
+		case m == nil || !strings.Contains(m[1], "NOSPLIT"):
+			errorf("expected NOSPLIT function\ngo:%s\nasm:%s\n", at.fn, fa)
+		case strings.Contains(fa, "morestack"):
+			errorf("unexpected stack check in NOSPLIT function\ngo:%s\nasm:%s\n", at.fn, fa)
+		default:
+			if frame, _ := strconv.Atoi(m[2]); frame > objabi.StackLimit {
+				errorf("NOSPLIT frame size %d exceeds stack limit %d\ngo:%s\nasm:%s\n", frame, objabi.StackLimit, at.fn, fa)
+			}
+		}
+	}
+	if at.golden != "" {
+		if err := at.checkGolden(fa); err != nil {
+			errorf("%v\ngo:%s\nasm:%s\n", err, at.fn, fa)
+		}
+	}
+	return failures
+}
+
+// boundsPanics lists the functions called when a bounds check fails,
+// which a test setting noBCE must not call.
+var boundsPanics = []string{"runtime.panicindex", "runtime.panicslice"}
+
+var (
+	// textNameRegexp matches the TEXT line of a function listing,
+	// capturing the function's name.
+	textNameRegexp = regexp.MustCompile(`TEXT\t"".(\S+)\(SB\)`)
+	// posRegexp matches the source position of an instruction.
+	posRegexp = regexp.MustCompile(` \(\S+\.go:\d+\)`)
+	// encRegexp matches the lines listing the encoding and the
+	// relocations of a function.
+	encRegexp = regexp.MustCompile(`^\t(0x[0-9a-f]{4} [0-9a-f]{2} |rel )`)
+)
+
+// goldenAsm returns the assembly fa as stored in golden files: without
+// positions, encodings and relocations, and with the name of the
+// function replaced by '$'.
+func goldenAsm(fa string) string {
+	var name string
+	if m := textNameRegexp.FindStringSubmatch(fa); m != nil {
+		name = `"".` + m[1]
+	}
+	var buf bytes.Buffer
+	for _, line := range strings.Split(strings.TrimSuffix(fa, "\n"), "\n") {
+		if encRegexp.MatchString(line) {
+			continue
+		}
+		line = posRegexp.ReplaceAllString(line, "")
+		if name != "" {
+			line = strings.Replace(line, name, `"".$`, -1)
+		}
+		buf.WriteString(line)
+		buf.WriteByte('\n')
+	}
+	return buf.String()
+}
+
+// checkGolden compares the assembly fa against the test's golden file,
+// or rewrites the golden file if the -update flag is set.
+func (at asmTest) checkGolden(fa string) error {
+	file := filepath.Join("testdata", "asm", at.golden)
+	got := goldenAsm(fa)
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+			return err
+		}
+		return ioutil.WriteFile(file, []byte(got), 0644)
+	}
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return fmt.Errorf("%v (run with -update to create it)", err)
+	}
+	want := string(b)
+	if got == want {
+		return nil
+	}
+	gotLines, wantLines := strings.Split(got, "\n"), strings.Split(want, "\n")
+	for i := 0; i < len(gotLines) || i < len(wantLines); i++ {
+		var g, w string
+		if i < len(gotLines) {
+			g = gotLines[i]
+		}
+		if i < len(wantLines) {
+			w = wantLines[i]
+		}
+		if g != w {
+			return fmt.Errorf("%s:%d: got %q, want %q", file, i+1, g, w)
+		}
+	}
+	return fmt.Errorf("%s: got %d lines, want %d", file, len(gotLines), len(wantLines))
+}
+
+// textRegexp matches the TEXT line of a function listing, capturing
+// its flags and frame size.
+var textRegexp = regexp.MustCompile(`TEXT\t\S+, (\S+), \$(-?\d+)-\d+`)
+
+// textSizesRegexp matches the TEXT line at the start of a function
+// listing, with or without flags, capturing its frame and argument sizes.
+var textSizesRegexp = regexp.MustCompile(`^TEXT\t\S+, (?:\S+, )?\$(-?\d+)-(\d+)`)
+
+// textSizes returns the frame and argument sizes in the TEXT line that
+// starts fa, e.g. 32 and 24 for "TEXT\t"".f(SB), $32-24". It reports
+// false if fa does not start with a TEXT line.
+func textSizes(fa string) (frame, args int, ok bool) {
+	m := textSizesRegexp.FindStringSubmatch(fa)
+	if m == nil {
+		return 0, 0, false
+	}
+	frame, _ = strconv.Atoi(m[1])
+	args, _ = strconv.Atoi(m[2])
+	return frame, args, true
+}
+
+// asmRegs lists, for each architecture, the general purpose and floating
+// point registers that the register allocator may assign. Registers with
+// a fixed role in the generated code (stack and frame pointers, link
+// register, g) are left out, since nearly every function mentions them.
+var asmRegs = map[string]struct{ gp, fp string }{
+	"amd64": {
+		gp: "AX CX DX BX SI DI R8 R9 R10 R11 R12 R13 R14 R15",
+		fp: "X0 X1 X2 X3 X4 X5 X6 X7 X8 X9 X10 X11 X12 X13 X14 X15",
+	},
+	"386": {
+		gp: "AX CX DX BX BP SI DI",
+		fp: "X0 X1 X2 X3 X4 X5 X6 X7",
+	},
+	"arm": {
+		gp: "R0 R1 R2 R3 R4 R5 R6 R7 R8 R9 R12",
+		fp: "F0 F1 F2 F3 F4 F5 F6 F7 F8 F9 F10 F11 F12 F13 F14 F15",
+	},
+	"arm64": {
+		gp: "R0 R1 R2 R3 R4 R5 R6 R7 R8 R9 R10 R11 R12 R13 R14 R15 R16 R17 R19 R20 R21 R22 R23 R24 R25 R26",
+		fp: "F0 F1 F2 F3 F4 F5 F6 F7 F8 F9 F10 F11 F12 F13 F14 F15 F16 F17 F18 F19 F20 F21 F22 F23 F24 F25 F26 F27 F28 F29 F30 F31",
+	},
+	"s390x": {
+		gp: "R0 R1 R2 R3 R4 R5 R6 R7 R8 R9 R12",
+		fp: "F0 F1 F2 F3 F4 F5 F6 F7 F8 F9 F10 F11 F12 F13 F14 F15",
+	},
+	"mips": {
+		gp: "R1 R2 R3 R4 R5 R6 R7 R8 R9 R10 R11 R12 R13 R14 R15 R16 R17 R18 R19 R20 R21 R22 R24 R25 R28",
+		fp: "F0 F2 F4 F6 F8 F10 F12 F14 F16 F18 F20 F22 F24 F26 F28 F30",
+	},
+	"mips64": {
+		gp: "R1 R2 R3 R4 R5 R6 R7 R8 R9 R10 R11 R12 R13 R14 R15 R16 R17 R18 R19 R20 R21 R22 R24 R25",
+		fp: "F0 F1 F2 F3 F4 F5 F6 F7 F8 F9 F10 F11 F12 F13 F14 F15 F16 F17 F18 F19 F20 F21 F22 F23 F24 F25 F26 F27 F28 F29 F30 F31",
+	},
+	"ppc64le": {
+		gp: "R3 R4 R5 R6 R7 R8 R9 R10 R11 R12 R14 R15 R16 R17 R18 R19 R20 R21 R22 R23 R24 R25 R26 R27 R28 R29",
+		fp: "F1 F2 F3 F4 F5 F6 F7 F8 F9 F10 F11 F12 F13 F14 F15 F16 F17 F18 F19 F20 F21 F22 F23 F24 F25 F26",
+	},
+}
+
+// funcRegs returns the sorted set of registers from the space-separated
+// list names that appear as instruction operands in fa.
+func funcRegs(fa string, names string) []string {
+	re := regexp.MustCompile(`\b(` + strings.Join(strings.Fields(names), "|") + `)\b`)
+	seen := make(map[string]bool)
+	var regs []string
+	for _, line := range strings.Split(fa, "\n") {
+		// Instruction lines look like
+		// "\t0x0000 00000 (x.go:3)\tMOVQ\t"".x+8(SP), AX";
+		// the hex dump and relocation lines have no further tabs.
+		f := strings.SplitN(line, "\t", 4)
+		if len(f) < 4 {
+			continue
+		}
+		for _, r := range re.FindAllString(f[3], -1) {
+			if !seen[r] {
+				seen[r] = true
+				regs = append(regs, r)
+			}
+		}
+	}
+	sort.Strings(regs)
+	return regs
+}
+
+// asmFrame lists, for some architectures, the instructions of the
+// stack check at the start of a function, split into the instructions
+// computing the check and the branches to the morestack call, of the
+// frame setup following it, and of the frame teardown before a return.
+var asmFrame = map[string]struct{ check, branch, prologue, epilogue *regexp.Regexp }{
+	"amd64": {
+		check: instsRegexp(
+			`MOVQ\t\(TLS\), CX`, `CMPQ\tSP, 16\(CX\)`,
+			`LEAQ\t-\d+\(SP\), R12`, `CMPQ\tR12, 16\(CX\)`,
+			`MOVQ\t16\(CX\), SI`, `CMPQ\tSI, \$-\d+`, `LEAQ\t\d+\(SP\), AX`, `SUBQ\tSI, AX`, `CMPQ\tAX, \$\d+`),
+		branch:   instsRegexp(`J(LS|EQ)\t\d+`),
+		prologue: instsRegexp(`SUBQ\t\$\d+, SP`, `MOVQ\tBP, \d+\(SP\)`, `LEAQ\t\d+\(SP\), BP`),
+		epilogue: instsRegexp(`MOVQ\t\d+\(SP\), BP`, `ADDQ\t\$\d+, SP`),
+	},
+	"386": {
+		check: instsRegexp(
+			`MOVL\tTLS, CX`, `MOVL\t\(CX\)\(TLS\*2\), CX`, `CMPL\tSP, 8\(CX\)`,
+			`LEAL\t-\d+\(SP\), AX`, `CMPL\tAX, 8\(CX\)`,
+			`MOVL\t8\(CX\), SI`, `CMPL\tSI, \$-\d+`, `LEAL\t\d+\(SP\), AX`, `SUBL\tSI, AX`, `CMPL\tAX, \$\d+`),
+		branch:   instsRegexp(`J(LS|EQ)\t\d+`),
+		prologue: instsRegexp(`SUBL\t\$\d+, SP`),
+		epilogue: instsRegexp(`ADDL\t\$\d+, SP`),
+	},
+	"arm64": {
+		check: instsRegexp(
+			`MOVD\t16\(g\), R1`, `MOVD\tRSP, R2`, `CMP\tR1, R2`,
+			`SUB\t\$\d+, RSP, R2`,
+			`CMP\t\$-\d+, R1`, `ADD\t\$\d+, RSP, R2`, `SUB\tR1, R2`, `MOVD\t\$\d+, R3`, `CMP\tR3, R2`),
+		branch:   instsRegexp(`B(LS|EQ)\t\d+`),
+		prologue: instsRegexp(`MOVD\.W\tR30, -\d+\(RSP\)`, `SUB\t\$\d+, RSP, R27`, `MOVD\tR30, \(R27\)`, `MOVD\tR27, RSP`),
+		epilogue: instsRegexp(`MOVD\.P\t\d+\(RSP\), R30`, `ADD\t\$\d+, RSP`),
+	},
+}
+
+// retRegexp and morestackRegexp match a return and the instructions
+// of the call to morestack at the end of a function.
+var (
+	retRegexp       = regexp.MustCompile(`^RET\b`)
+	morestackRegexp = instsRegexp(`NOP`, `MOVD\tR30, R3`, `CALL\truntime\.morestack\w*\(SB\)`, `JMP\t0`)
+)
+
+// instsRegexp returns a regexp matching any one of the given
+// instruction regexps in full.
+func instsRegexp(insts ...string) *regexp.Regexp {
+	return regexp.MustCompile(`^(` + strings.Join(insts, "|") + `)$`)
+}
+
+// frameInsts returns the number of instructions in fa spent on the
+// stack check, frame setup and teardown and returns. It reports false
+// if the frame instructions of arch are not known.
+func frameInsts(fa string, arch string) (int, bool) {
+	fr, ok := asmFrame[arch]
+	if !ok {
+		return 0, false
+	}
+	insts := asmInsts(fa)
+	n := 0
+	for n < len(insts) && (fr.check.MatchString(insts[n].text) || fr.branch.MatchString(insts[n].text) || fr.prologue.MatchString(insts[n].text)) {
+		n++
+	}
+	body := n
+	for i := body; i < len(insts); i++ {
+		switch {
+		case retRegexp.MatchString(insts[i].text):
+			n++
+			for j := i - 1; j >= body && fr.epilogue.MatchString(insts[j].text); j-- {
+				n++
+			}
+		case morestackRegexp.MatchString(insts[i].text):
+			n++
+		}
+	}
+	return n, true
+}
+
+// stackCheckFirst returns "" if fa starts with the stack check, ending
+// with a branch to the morestack call, and otherwise the instruction
+// that comes first or interrupts it. It reports false if the stack
+// check of arch is not known.
+func stackCheckFirst(fa string, arch string) (string, bool) {
+	fr, ok := asmFrame[arch]
+	if !ok {
+		return "", false
+	}
+	insts := asmInsts(fa)
+	n := 0
+	for n < len(insts) && (fr.check.MatchString(insts[n].text) || fr.branch.MatchString(insts[n].text)) {
+		n++
+	}
+	switch {
+	case n > 0 && fr.branch.MatchString(insts[n-1].text):
+		return "", true
+	case n == len(insts):
+		return "end of function", true
+	}
+	return insts[n].text, true
+}
+
+// branchRegexp matches a branch to a pc in the function.
+var branchRegexp = regexp.MustCompile(`^(\w+)\t(\d+)$`)
+
+// asmBody returns the instructions of fa past the stack check and
+// frame setup. Branch targets are rewritten from pcs to instruction
+// numbers counted from the start of the body, so that the bodies of
+// functions whose prologues differ in size can be compared. It reports
+// false if the frame instructions of arch are not known.
+func asmBody(fa string, arch string) ([]string, bool) {
+	fr, ok := asmFrame[arch]
+	if !ok {
+		return nil, false
+	}
+	insts := asmInsts(fa)
+	n := 0
+	for n < len(insts) && (fr.check.MatchString(insts[n].text) || fr.branch.MatchString(insts[n].text) || fr.prologue.MatchString(insts[n].text)) {
+		n++
+	}
+	target := make(map[string]string)
+	for i := len(insts) - 1; i >= 0; i-- {
+		if i < n {
+			target[insts[i].pc] = "prologue"
+		} else {
+			target[insts[i].pc] = fmt.Sprintf("@%d", i-n)
+		}
+	}
+	var body []string
+	for _, inst := range insts[n:] {
+		if m := branchRegexp.FindStringSubmatch(inst.text); m != nil && target[m[2]] != "" {
+			body = append(body, m[1]+"\t"+target[m[2]])
+		} else {
+			body = append(body, inst.text)
+		}
+	}
+	return body, true
+}
+
+// An asmInst is an instruction of an assembly listing.
+type asmInst struct {
+	pc   string // decimal offset in the function, e.g. "00012"
+	text string // e.g. "MOVQ\t"".x+8(SP), AX"
+}
+
+// asmInsts returns the instructions of fa, leaving out the FUNCDATA
+// and PCDATA pseudo-instructions.
+func asmInsts(fa string) []asmInst {
+	var insts []asmInst
+	for _, line := range strings.Split(fa, "\n") {
+		// Instruction lines look like
+		// "\t0x0000 00000 (x.go:3)\tMOVQ\t"".x+8(SP), AX".
+		f := strings.SplitN(line, "\t", 3)
+		if len(f) < 3 || f[0] != "" || strings.HasPrefix(f[2], "FUNCDATA") || strings.HasPrefix(f[2], "PCDATA") {
+			continue
 		}
+		if pc := strings.Fields(f[1]); len(pc) >= 2 {
+			insts = append(insts, asmInst{pc: pc[1], text: f[2]})
+		}
+	}
+	return insts
+}
+
+// asmMoves lists, for some architectures, the instructions used to
+// copy one register to another of the same kind.
+var asmMoves = map[string]string{
+	"amd64":   "MOVQ MOVL MOVSS MOVSD MOVAPS MOVUPS",
+	"386":     "MOVL MOVSS MOVSD MOVAPS MOVUPS",
+	"arm":     "MOVW MOVF MOVD",
+	"arm64":   "MOVD FMOVS FMOVD",
+	"s390x":   "MOVD FMOVS FMOVD",
+	"mips":    "MOVW MOVF MOVD",
+	"mips64":  "MOVV MOVF MOVD",
+	"ppc64le": "MOVD FMOVD",
+}
+
+// vexInsts is a neg list matching the VEX-encoded AVX instructions of
+// amd64, whose mnemonics all start with V. The compiler must not emit
+// them for the baseline amd64, which is all it targets for now, since
+// the CPUs it includes may lack AVX.
+var vexInsts = []string{"\tV[A-Z][A-Z0-9]*\t"}
+
+// copyChain returns the first pair of adjacent register to register
+// moves in fa where the second move copies the register written by the
+// first, or "" if there is none. It reports false if the moves of arch
+// are not known.
+func copyChain(fa string, arch string) (string, bool) {
+	ops, ok := asmMoves[arch]
+	if !ok {
+		return "", false
+	}
+	regs := `(` + strings.Join(strings.Fields(asmRegs[arch].gp+" "+asmRegs[arch].fp), "|") + `)`
+	re := regexp.MustCompile(`^(` + strings.Join(strings.Fields(ops), "|") + `)\t` + regs + `, ` + regs + `$`)
+	var prev []string
+	for _, inst := range asmInsts(fa) {
+		m := re.FindStringSubmatch(inst.text)
+		if m != nil && prev != nil && m[1] == prev[1] && m[2] == prev[3] {
+			return prev[0] + "; " + m[0], true
+		}
+		prev = m
 	}
+	return "", true
 }
 
 type asmTests struct {
 	arch    string
 	os      string
+	goarm   string // GOARM to compile with, if not the toolchain default
 	imports []string
-	tests   []*asmTest
+	// extra flags for go tool compile, such as -race
+	flags []string
+	// extra environment variables for the go command, such as GO386=387
+	env []string
+	// the level of -S to compile with, 2 for -S=2; 0 and 1 are -S.
+	// With -S=2 the listing annotated with SSA values is appended to
+	// each function's listing, and the regexps may match either.
+	sLevel int
+	tests  []*asmTest
+}
+
+// name returns the name of the test group, made of the target OS,
+// architecture, GOARM and any compiler flags and environment variables,
+// e.g. "linux/amd64/race", "linux/arm/v7" or "linux/386/GO386=387".
+func (ats *asmTests) name() string {
+	name := ats.os + "/" + ats.arch
+	if ats.goarm != "" {
+		name += "/v" + ats.goarm
+	}
+	if ats.sLevel > 1 {
+		name += fmt.Sprintf("/S=%d", ats.sLevel)
+	}
+	for _, f := range ats.flags {
+		name += "/" + strings.TrimLeft(f, "-")
+	}
+	for _, e := range ats.env {
+		name += "/" + e
+	}
+	return name
+}
+
+// funcPlaceholderRegexp matches the '$name' placeholders of the other
+// functions of a test.
+var funcPlaceholderRegexp = regexp.MustCompile(`\$[A-Za-z_]\w*`)
+
+// funcName returns the name of the function called name in the i'th
+// test, replacing a '$name' placeholder by its unique name.
+func (ats *asmTests) funcName(i int, name string) string {
+	if strings.HasPrefix(name, "$") {
+		return fmt.Sprintf("f%d_%s_%s", i, ats.arch, name[1:])
+	}
+	return name
+}
+
+// funcNames returns the names of the functions called names in the
+// i'th test, as funcName does.
+func (ats *asmTests) funcNames(i int, names []string) []string {
+	var fns []string
+	for _, name := range names {
+		fns = append(fns, ats.funcName(i, name))
+	}
+	return fns
+}
+
+// allImports returns the imports of the test group followed by those
+// of its tests, without duplicates. All the tests are compiled in one
+// file, so they share the imports, except for tests with flags of their
+// own, which alone compiles separately.
+func (ats *asmTests) allImports() []string {
+	var imports []string
+	seen := make(map[string]bool)
+	add := func(pkgs []string) {
+		for _, p := range pkgs {
+			if !seen[p] {
+				seen[p] = true
+				imports = append(imports, p)
+			}
+		}
+	}
+	add(ats.imports)
+	for _, t := range ats.tests {
+		if len(t.flags) > 0 {
+			// compiled on its own, see alone
+			continue
+		}
+		add(t.imports)
+	}
+	return imports
 }
 
 func (ats *asmTests) generateCode() []byte {
 	var buf bytes.Buffer
 	fmt.Fprintln(&buf, "package main")
-	for _, s := range ats.imports {
+	for _, s := range ats.allImports() {
 		fmt.Fprintf(&buf, "import %q\n", s)
 	}
 
+	seen := make(map[string]bool)
+	for _, t := range ats.tests {
+		if t.decls != "" && !seen[t.decls] {
+			seen[t.decls] = true
+			fmt.Fprintln(&buf, t.decls)
+		}
+	}
+
 	for i, t := range ats.tests {
-		function := strings.Replace(t.fn, "func $", fmt.Sprintf("func f%d_%s", i, ats.arch), 1)
+		if len(t.flags) > 0 {
+			// compiled on its own, see alone
+			continue
+		}
+		function := funcPlaceholderRegexp.ReplaceAllStringFunc(t.fn, func(name string) string {
+			return ats.funcName(i, name)
+		})
+		function = strings.Replace(function, "func $", fmt.Sprintf("func f%d_%s", i, ats.arch), 1)
+		if t.nosplit {
+			fmt.Fprint(&buf, "//go:nosplit")
+		}
 		fmt.Fprintln(&buf, function)
 	}
 
 	return buf.Bytes()
 }
 
-// compile compiles the package pkg for architecture arch and
+// An asmVariant is one of the configurations that a test is run in.
+type asmVariant struct {
+	// extra flags for go tool compile; none for the default
+	flags []string
+	// regexps that must and must not match the assembly of this
+	// variant, in addition to those of the test
+	pos, neg []string
+}
+
+// withVariants returns ats with each test that has variants replaced
+// by one test per variant, which has the flags and the pos and neg
+// regexps of the variant added to those of the test.
+func (ats *asmTests) withVariants() *asmTests {
+	var tests []*asmTest
+	for _, at := range ats.tests {
+		if len(at.variants) == 0 {
+			tests = append(tests, at)
+			continue
+		}
+		for _, v := range at.variants {
+			vt := *at
+			vt.variants = nil
+			vt.flags = append(append([]string(nil), at.flags...), v.flags...)
+			vt.pos = append(append([]string(nil), at.pos...), v.pos...)
+			vt.neg = append(append([]string(nil), at.neg...), v.neg...)
+			tests = append(tests, &vt)
+		}
+	}
+	ots := *ats
+	ots.tests = tests
+	return &ots
+}
+
+// alone returns an array of tests holding only the i'th test of ats,
+// with its flags moved to the array. It keeps only the imports that
+// the test seems to use, since the compiler rejects unused imports.
+func (ats *asmTests) alone(i int) *asmTests {
+	at := *ats.tests[i]
+	ots := *ats
+	ots.flags = append(append([]string(nil), ats.flags...), at.flags...)
+	at.flags = nil
+	ots.tests = []*asmTest{&at}
+	ots.imports = nil
+	for _, p := range ats.imports {
+		if strings.Contains(at.fn, path.Base(p)+".") {
+			ots.imports = append(ots.imports, p)
+		}
+	}
+	return &ots
+}
+
+// compileToAsm compiles the package pkg for architecture arch and
 // returns the generated assembly.  dir is a scratch directory.
 func (ats *asmTests) compileToAsm(t *testing.T, dir string) string {
 	// create test directory
-	testDir := filepath.Join(dir, fmt.Sprintf("%s_%s", ats.arch, ats.os))
+	testDir := filepath.Join(dir, strings.Replace(ats.name(), "/", "_", -1))
 	err := os.Mkdir(testDir, 0700)
 	if err != nil {
 		t.Fatalf("could not create directory: %v", err)
@@ -187,51 +1171,236 @@ func (ats *asmTests) compileToAsm(t *testing.T, dir string) string {
 
 	// First, install any dependencies we need.  This builds the required export data
 	// for any packages that are imported.
-	for _, i := range ats.imports {
-		out := filepath.Join(testDir, i+".a")
+	ats.buildImports(t, testDir)
 
-		if s := ats.runGo(t, "build", "-o", out, "-gcflags=-dolinkobj=false", i); s != "" {
-			t.Fatalf("Stdout = %s\nWant empty", s)
-		}
+	// Now, compile the individual file for which we want to see the generated assembly.
+	return ats.compile(t, testDir)
+}
+
+// compile compiles the source file written by compileToAsm in testDir
+// and returns the generated assembly.
+func (ats *asmTests) compile(t *testing.T, testDir string) string {
+	args := append([]string{"tool", "compile"}, ats.flags...)
+	sflag := "-S"
+	if ats.sLevel > 1 {
+		sflag = fmt.Sprintf("-S=%d", ats.sLevel)
 	}
+	args = append(args, "-I", testDir, sflag, "-o", filepath.Join(testDir, "out.o"), filepath.Join(testDir, "test.go"))
+	return ats.runGo(t, args...)
+}
 
-	// Now, compile the individual file for which we want to see the generated assembly.
-	asm := ats.runGo(t, "tool", "compile", "-I", testDir, "-S", "-o", filepath.Join(testDir, "out.o"), src)
-	return asm
+// dumpAsm writes the failures of the test of funcName, followed by the
+// assembly fa of the function, to a file in the test group's directory
+// under dir, and logs its path. Like dumpSSA, errors are logged rather
+// than failing the test.
+func (ats *asmTests) dumpAsm(t *testing.T, dir, funcName string, failures []string, fa string) {
+	testDir := filepath.Join(dir, strings.Replace(ats.name(), "/", "_", -1))
+	if err := os.MkdirAll(testDir, 0700); err != nil {
+		t.Logf("could not create directory: %v", err)
+		return
+	}
+	var buf bytes.Buffer
+	for _, f := range failures {
+		fmt.Fprintf(&buf, "%s\n", f)
+	}
+	fmt.Fprintf(&buf, "\n%s", fa)
+	file := filepath.Join(testDir, funcName+".fail.s")
+	if err := ioutil.WriteFile(file, buf.Bytes(), 0600); err != nil {
+		t.Logf("could not write assembly for %s: %v", funcName, err)
+		return
+	}
+	t.Logf("assembly for %s: %s", funcName, file)
 }
 
-// runGo runs go command with the given args and returns stdout string.
-// go is run with GOARCH and GOOS set as ats.arch and ats.os respectively
-func (ats *asmTests) runGo(t *testing.T, args ...string) string {
-	var stdout, stderr bytes.Buffer
+// dumpSSA compiles the test source again with GOSSAFUNC set to funcName
+// and logs the location of the generated ssa.html. It is a debugging aid
+// for failing tests, so errors are logged rather than failing the test.
+func (ats *asmTests) dumpSSA(t *testing.T, dir, funcName string) {
+	testDir := filepath.Join(dir, strings.Replace(ats.name(), "/", "_", -1))
+	ssaDir := filepath.Join(testDir, "ssa_"+funcName)
+	if err := os.Mkdir(ssaDir, 0700); err != nil {
+		t.Logf("could not create directory: %v", err)
+		return
+	}
+	args := append([]string{"tool", "compile"}, ats.flags...)
+	args = append(args, "-I", testDir, "-o", filepath.Join(ssaDir, "out.o"), filepath.Join(testDir, "test.go"))
 	cmd := exec.Command(testenv.GoToolPath(t), args...)
-	cmd.Env = append(os.Environ(), "GOARCH="+ats.arch, "GOOS="+ats.os)
-	cmd.Stdout = &stdout
-	cmd.Stderr = &stderr
+	cmd.Env = append(ats.environ(), "GOSSAFUNC="+funcName)
+	cmd.Dir = ssaDir // ssa.html is written to the current directory
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Logf("could not dump SSA for %s: %v\n%s", funcName, err, out)
+		return
+	}
+	t.Logf("SSA dump for %s: %s", funcName, filepath.Join(ssaDir, "ssa.html"))
+}
 
-	if err := cmd.Run(); err != nil {
-		t.Fatalf("error running cmd: %v\nstdout:\n%sstderr:\n%s\n", err, stdout.String(), stderr.String())
+// environ returns the environment to run the go command in: GOARCH and
+// GOOS are set as ats.arch and ats.os respectively, GOARM as ats.goarm if
+// it is not empty, and the variables in ats.env are added last.
+func (ats *asmTests) environ() []string {
+	env := append(os.Environ(), "GOARCH="+ats.arch, "GOOS="+ats.os)
+	if ats.goarm != "" {
+		env = append(env, "GOARM="+ats.goarm)
 	}
+	return append(env, ats.env...)
+}
 
-	if s := stderr.String(); s != "" {
-		t.Fatalf("Stderr = %s\nWant empty", s)
+// importBuilds bounds the number of imports built at once by all the
+// test groups together.
+var importBuilds = make(chan bool, runtime.NumCPU())
+
+// buildImports builds the export data of the packages returned by
+// ats.allImports into testDir. The packages are built concurrently, since each is
+// written to a file of its own and none needs another's.
+func (ats *asmTests) buildImports(t *testing.T, testDir string) {
+	gotool := testenv.GoToolPath(t)
+	imports := ats.allImports()
+	errs := make([]error, len(imports))
+	var wg sync.WaitGroup
+	for i, imp := range imports {
+		wg.Add(1)
+		go func(i int, imp string) {
+			defer wg.Done()
+			importBuilds <- true
+			defer func() { <-importBuilds }()
+			out := filepath.Join(testDir, imp+".a")
+			s, err := ats.goCommand(gotool, "build", "-o", out, "-gcflags=-dolinkobj=false", imp)
+			if err == nil && s != "" {
+				err = fmt.Errorf("Stdout = %s\nWant empty", s)
+			}
+			errs[i] = err
+		}(i, imp)
 	}
+	wg.Wait()
+	failed := false
+	for _, err := range errs {
+		if err != nil {
+			t.Error(err)
+			failed = true
+		}
+	}
+	if failed {
+		t.FailNow()
+	}
+}
 
-	return stdout.String()
+// runGo runs go command with the given args and returns stdout string.
+// go is run in the environment returned by ats.environ.
+func (ats *asmTests) runGo(t *testing.T, args ...string) string {
+	s, err := ats.goCommand(testenv.GoToolPath(t), args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
 }
 
-var allAsmTests = []*asmTests{
-	{
-		arch:    "amd64",
-		os:      "linux",
-		imports: []string{"unsafe", "runtime"},
-		tests:   linuxAMD64Tests,
-	},
-	{
-		arch:  "386",
+// goCommand is like runGo, but runs the go command at path gotool and
+// returns an error instead of failing a test, so that it may be used
+// by other goroutines than the test's.
+func (ats *asmTests) goCommand(gotool string, args ...string) (string, error) {
+	timeout, err := runGoTimeout()
+	if err != nil {
+		return "", err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	// The output goes to files rather than pipes: killing "go tool"
+	// leaves the tool it started running, and Run would wait for it
+	// to close the pipes.
+	var out [2]*os.File
+	for i := range out {
+		f, err := ioutil.TempFile("", "runGo")
+		if err != nil {
+			return "", fmt.Errorf("could not create output file: %v", err)
+		}
+		defer os.Remove(f.Name())
+		defer f.Close()
+		out[i] = f
+	}
+	cmd := exec.CommandContext(ctx, gotool, args...)
+	cmd.Env = ats.environ()
+	cmd.Stdout = out[0]
+	cmd.Stderr = out[1]
+
+	err = cmd.Run()
+	stdout, _ := ioutil.ReadFile(out[0].Name())
+	stderr, _ := ioutil.ReadFile(out[1].Name())
+	if ctx.Err() == context.DeadlineExceeded {
+		name := "go " + args[0]
+		if args[0] == "tool" {
+			name += " " + args[1]
+		}
+		return "", fmt.Errorf("%s timed out for %s after %v\nstdout:\n%sstderr:\n%s\n", name, ats.name(), timeout, stdout, stderr)
+	}
+	if err != nil {
+		return "", fmt.Errorf("error running cmd: %v\nstdout:\n%sstderr:\n%s\n", err, stdout, stderr)
+	}
+
+	if len(stderr) != 0 {
+		return "", fmt.Errorf("Stderr = %s\nWant empty", stderr)
+	}
+
+	return string(stdout), nil
+}
+
+// runGoTimeout returns how long runGo lets a go command run: the
+// duration in GOASMTIMEOUT if it is set, and two minutes otherwise.
+func runGoTimeout() (time.Duration, error) {
+	s := os.Getenv("GOASMTIMEOUT")
+	if s == "" {
+		return 2 * time.Minute, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("bad GOASMTIMEOUT: %v", err)
+	}
+	return d, nil
+}
+
+var allAsmTests = []*asmTests{
+	{
+		arch:    "amd64",
+		os:      "linux",
+		imports: []string{"unsafe", "runtime", "math", "math/bits"},
+		tests:   linuxAMD64Tests,
+	},
+	{
+		arch:  "amd64",
+		os:    "linux",
+		flags: []string{"-race"},
+		tests: linuxAMD64RaceTests,
+	},
+	{
+		arch:    "amd64",
+		os:      "linux",
+		imports: []string{"unsafe"},
+		flags:   []string{"-msan"},
+		tests:   linuxAMD64MsanTests,
+	},
+	{
+		arch:  "amd64",
+		os:    "linux",
+		flags: []string{"-shared"},
+		tests: linuxAMD64SharedTests,
+	},
+	{
+		arch:   "amd64",
+		os:     "linux",
+		sLevel: 2,
+		tests:  linuxAMD64SSATests,
+	},
+	{
+		arch:  "386",
 		os:    "linux",
 		tests: linux386Tests,
 	},
+	{
+		arch:  "386",
+		os:    "linux",
+		env:   []string{"GO386=387"},
+		tests: linux386x87Tests,
+	},
 	{
 		arch:  "s390x",
 		os:    "linux",
@@ -244,9 +1413,22 @@ var allAsmTests = []*asmTests{
 		tests:   linuxARMTests,
 	},
 	{
-		arch:  "arm64",
+		arch:  "arm",
+		os:    "linux",
+		goarm: "5",
+		tests: linuxARMv5Tests,
+	},
+	{
+		arch:  "arm",
 		os:    "linux",
-		tests: linuxARM64Tests,
+		goarm: "7",
+		tests: linuxARMv7Tests,
+	},
+	{
+		arch:    "arm64",
+		os:      "linux",
+		imports: []string{"math"},
+		tests:   linuxARM64Tests,
 	},
 	{
 		arch:  "mips",
@@ -259,18 +1441,159 @@ var allAsmTests = []*asmTests{
 		tests: linuxMIPS64Tests,
 	},
 	{
-		arch:  "ppc64le",
+		arch:  "ppc64",
 		os:    "linux",
-		tests: linuxPPC64LETests,
+		tests: linuxPPC64Tests,
+	},
+	{
+		arch:    "ppc64le",
+		os:      "linux",
+		imports: []string{"math/bits"},
+		tests:   linuxPPC64LETests,
 	},
 	{
 		arch:  "amd64",
 		os:    "plan9",
 		tests: plan9AMD64Tests,
 	},
+	{
+		arch:  "amd64",
+		os:    "darwin",
+		tests: darwinAMD64Tests,
+	},
+	{
+		arch:  "arm64",
+		os:    "darwin",
+		tests: darwinARM64Tests,
+	},
 }
 
 var linuxAMD64Tests = []*asmTest{
+	// Storing a pointer to a global needs a write barrier,
+	// storing an integer next to it does not.
+	{
+		fn: `
+		func $(p *int) {
+			wbGlobal.p = p
+		}
+		`,
+		decls: `
+		var wbGlobal struct {
+			p *int
+			n int
+		}
+		`,
+		pos: []string{"\tCMPL\truntime\\.writeBarrier\\(SB\\), \\$0", "\tCALL\truntime\\.gcWriteBarrier\\(SB\\)"},
+	},
+	{
+		fn: `
+		func $(n int) {
+			wbGlobal.n = n
+		}
+		`,
+		decls: `
+		var wbGlobal struct {
+			p *int
+			n int
+		}
+		`,
+		pos: []string{"\tMOVQ\t[A-Z]+, \"\"\\.wbGlobal\\+8\\(SB\\)"},
+		neg: []string{"writeBarrier"},
+	},
+	// Register allocation and block layout do not depend on map
+	// iteration order or other nondeterminism in the compiler.
+	{
+		fn: `
+		func $(m map[string]int, keys []string, x, y, z int) int {
+			s := 0
+			for k, v := range m {
+				switch k {
+				case "a", "b", "c":
+					s += v * x
+				case "d", "e":
+					s -= v * y
+				case "f", "g", "h", "i":
+					s ^= v + z
+				}
+			}
+			for _, k := range keys {
+				s += m[k] * (x + y*z)
+			}
+			return s
+		}
+		`,
+		deterministic: true,
+	},
+	{
+		fn: `
+		func $(a []float64, b []int) (float64, int) {
+			var f, g, h float64
+			var i, j, k int
+			for n := range a {
+				f += a[n] * g
+				g -= a[n] * h
+				h *= float64(b[n] + i)
+				i += b[n] << uint(j)
+				j ^= i + k
+				k = j - i
+			}
+			return f + g + h, i + j + k
+		}
+		`,
+		deterministic: true,
+	},
+	// The runtime's atomics are intrinsified on amd64: a load is a
+	// plain load, an add is a LOCK XADD.
+	{
+		fn: `
+		func $(p *uint64) uint64 {
+			return atomic.Load64(p)
+		}
+		`,
+		imports:  []string{"runtime/internal/atomic"},
+		pos:      []string{"\tMOVQ\t\\([A-Z]+\\), [A-Z]+"},
+		negCalls: []string{"runtime/internal/atomic.Load64"},
+	},
+	{
+		fn: `
+		func $(p *uint64, d int64) uint64 {
+			return atomic.Xadd64(p, d)
+		}
+		`,
+		imports:  []string{"runtime/internal/atomic"},
+		pos:      []string{"\tLOCK\n", "\tXADDQ\t"},
+		negCalls: []string{"runtime/internal/atomic.Xadd64"},
+	},
+	{
+		fn: `
+		func $(p *uint32) uint32 {
+			return atomic.Xadd(p, 1)
+		}
+		`,
+		imports:  []string{"runtime/internal/atomic"},
+		pos:      []string{"\tLOCK\n", "\tXADDL\t"},
+		negCalls: []string{"runtime/internal/atomic.Xadd"},
+	},
+	// Struct copies, floating point arithmetic and clearing memory
+	// use SSE but not AVX.
+	{
+		fn: `
+		type T struct {
+			a [40]int64
+		}
+		func $(d, s *T, x, y float64, b []byte) float64 {
+			*d = *s
+			for i := range b {
+				b[i] = 0
+			}
+			var z [16]byte
+			copy(b, z[:])
+			return x + y
+		}
+		`,
+		pos: []string{"\tDUFFCOPY\t", "\tCALL\truntime\\.memclrNoHeapPointers\\(SB\\)", "\tMOVUPS\t", "\tADDSD\t"},
+		neg: vexInsts,
+	},
 	{
 		fn: `
 		func $(x int) int {
@@ -461,103 +1784,2191 @@ var linuxAMD64Tests = []*asmTest{
 		}`,
 		pos: []string{"\tCMPQ\t[A-Z]"},
 	},
-	// Check that array compare uses 2/4/8 byte compares
+	// Check that array compare uses 2/4/8 byte compares
+	{
+		fn: `
+		func f68(a,b [2]byte) bool {
+		    return a == b
+		}`,
+		pos: []string{"\tCMPW\t\"\"[.+_a-z0-9]+\\(SP\\), [A-Z]"},
+	},
+	{
+		fn: `
+		func f69(a,b [3]uint16) bool {
+		    return a == b
+		}`,
+		pos: []string{
+			"\tCMPL\t\"\"[.+_a-z0-9]+\\(SP\\), [A-Z]",
+			"\tCMPW\t\"\"[.+_a-z0-9]+\\(SP\\), [A-Z]",
+		},
+	},
+	{
+		fn: `
+		func $(a,b [3]int16) bool {
+		    return a == b
+		}`,
+		pos: []string{
+			"\tCMPL\t\"\"[.+_a-z0-9]+\\(SP\\), [A-Z]",
+			"\tCMPW\t\"\"[.+_a-z0-9]+\\(SP\\), [A-Z]",
+		},
+	},
+	{
+		fn: `
+		func $(a,b [12]int8) bool {
+		    return a == b
+		}`,
+		pos: []string{
+			"\tCMPQ\t\"\"[.+_a-z0-9]+\\(SP\\), [A-Z]",
+			"\tCMPL\t\"\"[.+_a-z0-9]+\\(SP\\), [A-Z]",
+		},
+	},
+	{
+		fn: `
+		func f70(a,b [15]byte) bool {
+		    return a == b
+		}`,
+		pos: []string{"\tCMPQ\t\"\"[.+_a-z0-9]+\\(SP\\), [A-Z]"},
+	},
+	{
+		fn: `
+		func f71(a,b unsafe.Pointer) bool { // This was a TODO in mapaccess1_faststr
+		    return *((*[4]byte)(a)) != *((*[4]byte)(b))
+		}`,
+		pos: []string{"\tCMPL\t\\(.*\\), [A-Z]"},
+	},
+	{
+		// make sure assembly output has matching offset and base register.
+		fn: `
+		func f72(a, b int) int {
+			runtime.GC() // use some frame
+			return b
+		}
+		`,
+		pos: []string{"b\\+24\\(SP\\)"},
+	},
+	{
+		// check load combining
+		fn: `
+		func f73(a, b byte) (byte,byte) {
+		    return f73(f73(a,b))
+		}
+		`,
+		pos: []string{"\tMOVW\t"},
+	},
+	{
+		fn: `
+		func f74(a, b uint16) (uint16,uint16) {
+		    return f74(f74(a,b))
+		}
+		`,
+		pos: []string{"\tMOVL\t"},
+	},
+	{
+		fn: `
+		func f75(a, b uint32) (uint32,uint32) {
+		    return f75(f75(a,b))
+		}
+		`,
+		pos: []string{"\tMOVQ\t"},
+	},
+	// Make sure we don't put pointers in SSE registers across safe points.
+	{
+		fn: `
+		func $(p, q *[2]*int)  {
+		    a, b := p[0], p[1]
+		    runtime.GC()
+		    q[0], q[1] = a, b
+		}
+		`,
+		neg: []string{"MOVUPS"},
+	},
+	{
+		// check that stack store is optimized away
+		fn: `
+		func $() int {
+			var x int
+			return *(&x)
+		}
+		`,
+		frameSize: 0,
+		argSize:   8,
+	},
+	// int <-> fp moves
+	{
+		fn: `
+		func $(x uint32) bool {
+			return x > 4
+		}
+		`,
+		pos: []string{"\tSETHI\t.*\\(SP\\)"},
+	},
+	// Check that len() and cap() div by a constant power of two
+	// are compiled into SHRQ.
+	{
+		fn: `
+		func $(a []int) int {
+			return len(a) / 1024
+		}
+		`,
+		pos: []string{"\tSHRQ\t\\$10,"},
+	},
+	{
+		fn: `
+		func $(s string) int {
+			return len(s) / (4097 >> 1)
+		}
+		`,
+		pos: []string{"\tSHRQ\t\\$11,"},
+	},
+	{
+		fn: `
+		func $(a []int) int {
+			return cap(a) / ((1 << 11) + 2048)
+		}
+		`,
+		pos: []string{"\tSHRQ\t\\$12,"},
+	},
+	// The overflow-safe midpoint a+(b-a)/2 is a SUB, a shift and an ADD.
+	{
+		fn: `
+		func $(a, b uint) uint {
+			return a + (b-a)/2
+		}
+		`,
+		counts: map[string]int{"\tSUBQ\t": 1, "\tSHRQ\t\\$1, ": 1, "\tADDQ\t": 1},
+		neg:    []string{"\tSARQ\t"},
+	},
+	{
+		fn: `
+		func $(a, b int) int {
+			return a + (b-a)>>1
+		}
+		`,
+		counts: map[string]int{"\tSUBQ\t": 1, "\tSARQ\t\\$1, ": 1, "\tADDQ\t": 1},
+		neg:    []string{"\tSHRQ\t"},
+	},
+	// Signed division by 2 rounds toward zero, so it adds the sign bit
+	// of b-a before shifting, but takes it straight from b-a.
+	{
+		fn: `
+		func $(a, b int) int {
+			return a + (b-a)/2
+		}
+		`,
+		counts: map[string]int{"\tSUBQ\t": 1, "\tSHRQ\t\\$63, ": 1, "\tSARQ\t\\$1, ": 1, "\tADDQ\t": 2},
+		neg:    []string{"\tSARQ\t\\$63, "},
+	},
+	// Check that len() and cap() mod by a constant power of two
+	// are compiled into ANDQ.
+	{
+		fn: `
+		func $(a []int) int {
+			return len(a) % 1024
+		}
+		`,
+		pos: []string{"\tANDQ\t\\$1023,"},
+	},
+	{
+		fn: `
+		func $(s string) int {
+			return len(s) % (4097 >> 1)
+		}
+		`,
+		pos: []string{"\tANDQ\t\\$2047,"},
+	},
+	{
+		fn: `
+		func $(a []int) int {
+			return cap(a) % ((1 << 11) + 2048)
+		}
+		`,
+		pos: []string{"\tANDQ\t\\$4095,"},
+	},
+	{
+		// Test that small memmove was replaced with direct movs
+		fn: `
+                func $() {
+                       x := [...]byte{1, 2, 3, 4, 5, 6, 7}
+                       copy(x[1:], x[:])
+                }
+		`,
+		neg: []string{"memmove"},
+	},
+	{
+		// Same as above but with different size
+		fn: `
+                func $() {
+                       x := [...]byte{1, 2, 3, 4}
+                       copy(x[1:], x[:])
+                }
+		`,
+		neg: []string{"memmove"},
+	},
+	{
+		// Same as above but with different size
+		fn: `
+                func $() {
+                       x := [...]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+                       copy(x[1:], x[:])
+                }
+		`,
+		neg: []string{"memmove"},
+	},
+	{
+		fn: `
+		func $(p int, q *int) bool {
+			return p < *q
+		}
+		`,
+		pos: []string{"CMPQ\t\\(.*\\), [A-Z]"},
+	},
+	{
+		fn: `
+		func $(p *int, q int) bool {
+			return *p < q
+		}
+		`,
+		pos: []string{"CMPQ\t\\(.*\\), [A-Z]"},
+	},
+	{
+		fn: `
+		func $(p *int) bool {
+			return *p < 7
+		}
+		`,
+		pos: []string{"CMPQ\t\\(.*\\), [$]7"},
+	},
+	{
+		fn: `
+		func $(p *int) bool {
+			return 7 < *p
+		}
+		`,
+		pos: []string{"CMPQ\t\\(.*\\), [$]7"},
+	},
+	{
+		fn: `
+		func $(p **int) {
+			*p = nil
+		}
+		`,
+		pos: []string{"CMPL\truntime.writeBarrier\\(SB\\), [$]0"},
+	},
+	// Check that the accumulator of a max reduction stays in a
+	// register and is updated with a CMOV.
+	{
+		fn: `
+		func $(s []int) int {
+			m := s[0]
+			for _, v := range s[1:] {
+				if v > m {
+					m = v
+				}
+			}
+			return m
+		}
+		`,
+		pos: []string{"\tCMOVQGT\t"},
+		neg: []string{"\"\"\\.m[+-][0-9]+\\(SP\\)", "autotmp"},
+	},
+	// Check register pressure of simple chains of arithmetic.
+	{
+		fn: `
+		func $(a, b, c, d int) int {
+			return a + b + c + d
+		}
+		`,
+		maxGPRs: 2,
+	},
+	{
+		fn: `
+		func $(x, y, z float64) float64 {
+			return x * y + z
+		}
+		`,
+		maxFPRs: 2,
+	},
+	// Check that a bool held in a local is branched on using the
+	// flags of the comparison, and only materialized when stored.
+	{
+		fn: `
+		func $(a, b int, p *int) {
+			done := a < b
+			*p = 1
+			if done {
+				*p = 2
+			}
+		}
+		`,
+		pos: []string{"\tCMPQ\t", "\tJ(GE|LT)\t"},
+		neg: []string{"\tTESTB\t", "\tSET"},
+	},
+	{
+		fn: `
+		func $(a, b int, p *int, q *bool) {
+			done := a < b
+			*q = done
+			if done {
+				*p = 2
+			}
+		}
+		`,
+		pos: []string{"\tSETLT\t", "\tMOVB\t"},
+	},
+	// Check that a quotient and remainder by the same constant share
+	// a single high multiply.
+	{
+		fn: `
+		func $(x uint64) (uint64, uint64) {
+			return x / 7, x % 7
+		}
+		`,
+		pos: []string{"\tMULQ\t"},
+		neg: []string{"(?s)MULQ.*MULQ", "DIVQ"},
+	},
+	{
+		fn: `
+		func $(x int64) (int64, int64) {
+			return x / 7, x % 7
+		}
+		`,
+		pos: []string{"\tIMULQ\t"},
+		neg: []string{"(?s)IMULQ.*IMULQ", "IDIVQ"},
+	},
+	// The multiply-high reduction of a random number to [0, n), as in
+	// runtime.fastrandn, is a multiply and a shift. It is not the same
+	// as r % n, so it is only used when written out; r % n stays a
+	// division.
+	{
+		fn: `
+		func $(r, n uint32) uint32 {
+			return uint32(uint64(r) * uint64(n) >> 32)
+		}
+		`,
+		pos: []string{"\tIMULQ\t", "\tSHRQ\t\\$32, "},
+		neg: []string{"DIV"},
+	},
+	{
+		fn: `
+		func $(r, n uint32) uint32 {
+			return r % n
+		}
+		`,
+		pos: []string{"\tDIVL\t"},
+		neg: []string{"MUL"},
+	},
+	// The 64-bit reduction needs the high word of the 128-bit product,
+	// which bits.Mul64 gives as a single MULQ.
+	{
+		fn: `
+		func $(r, n uint64) uint64 {
+			hi, _ := bits.Mul64(r, n)
+			return hi
+		}
+		`,
+		pos: []string{"\tMULQ\t"},
+		neg: []string{"DIVQ", "CALL"},
+	},
+	{
+		fn: `
+		func $(r, n uint64) uint64 {
+			return r % n
+		}
+		`,
+		pos: []string{"\tDIVQ\t"},
+		neg: []string{"MULQ"},
+	},
+	// Division by a constant is a high multiply by a magic number,
+	// followed by a shift and, for signed division, a correction
+	// by the sign of the dividend.
+	{
+		fn: `
+		func $(x uint64) uint64 {
+			return x / 3
+		}
+		`,
+		pos: []string{"\tMOVQ\t\\$-6148914691236517205, ", "\tMULQ\t", "\tSHRQ\t\\$1, DX"},
+		neg: []string{"DIVQ"},
+	},
+	{
+		fn: `
+		func $(x int64) int64 {
+			return x / 7
+		}
+		`,
+		pos: []string{"\tMOVQ\t\\$5270498306774157605, ", "\tIMULQ\t", "\tSARQ\t\\$1, DX", "\tSARQ\t\\$63, ", "\tSUBQ\t"},
+		neg: []string{"IDIVQ"},
+	},
+	// 32-bit division uses a 64-bit multiply, with no high multiply.
+	{
+		fn: `
+		func $(x uint32) uint32 {
+			return x % 3
+		}
+		`,
+		pos: []string{"\\$2863311531, ", "\tIMULQ\t", "\tSHRQ\t\\$33, "},
+		neg: []string{"DIVL", "\tMULL\t"},
+	},
+	// Division by a power of two is a shift, with no multiply. Signed
+	// division first adds 2^n-1 to negative dividends, to round toward
+	// zero.
+	{
+		fn: `
+		func $(x uint64) uint64 {
+			return x / 16
+		}
+		`,
+		pos: []string{"\tSHRQ\t\\$4, "},
+		neg: []string{"MULQ", "DIVQ", "SARQ"},
+	},
+	{
+		fn: `
+		func $(x int64) int64 {
+			return x / 16
+		}
+		`,
+		pos: []string{"\tSARQ\t\\$63, ", "\tSHRQ\t\\$60, ", "\tADDQ\t", "\tSARQ\t\\$4, "},
+		neg: []string{"MULQ", "DIVQ"},
+	},
+	// Check that 64-bit constants are materialized with a single move:
+	// a MOVQ with a 64-bit immediate when needed, and the shorter
+	// sign-extended or zero-extended 32-bit forms otherwise.
+	{
+		fn: `
+		func $() uint64 {
+			return 0x123456789abcdef0
+		}
+		`,
+		pos: []string{"\tMOVQ\t\\$1311768467463790320, [A-Z]"},
+		neg: []string{"\tMOVL\t", "\tSHLQ\t", "\tORQ\t"},
+	},
+	{
+		fn: `
+		func $() int64 {
+			return -0x80000000
+		}
+		`,
+		pos: []string{"\tMOVQ\t\\$-2147483648, \"\""},
+		neg: []string{"\tMOVL\t"},
+	},
+	{
+		fn: `
+		func $() uint64 {
+			return 0xffffffff
+		}
+		`,
+		pos: []string{"\tMOVL\t\\$4294967295, [A-Z]"},
+		neg: []string{"\tMOVQ\t\\$"},
+	},
+	// Comparing to math.MinInt64, which has no 32-bit immediate form,
+	// takes one MOVQ of the constant and one CMPQ. A safe abs checks
+	// for it once, and negates with a CMOV for the other inputs.
+	{
+		fn: `
+		func $(x int64) bool {
+			return x == math.MinInt64
+		}
+		`,
+		counts: map[string]int{"\tMOVQ\t\\$-9223372036854775808, [A-Z]+\n": 1, "\tCMPQ\t": 1, "\tMOVQ\t\\$": 1},
+		neg:    []string{"\tSHLQ\t", "\tNEGQ\t", "\tTESTQ\t"},
+	},
+	{
+		fn: `
+		func $(x int64) (int64, bool) {
+			if x == math.MinInt64 {
+				return 0, false
+			}
+			if x < 0 {
+				x = -x
+			}
+			return x, true
+		}
+		`,
+		counts: map[string]int{"\tMOVQ\t\\$-9223372036854775808, [A-Z]+\n": 1, "\tCMPQ\t": 1, "\tNEGQ\t": 1, "\tCMOVQLT\t": 1, "\tJ[A-Z]+\t": 1},
+	},
+	// math.MinInt32 fits the immediate of CMPL.
+	{
+		fn: `
+		func $(x int32) bool {
+			return x == math.MinInt32
+		}
+		`,
+		pos: []string{"\tCMPL\t[A-Z]+, \\$-2147483648\n"},
+		neg: []string{"\tMOV[LQ]\t\\$"},
+	},
+	// The loop exit test i < len(s) also proves s[i] in bounds, so
+	// one compare governs both.
+	{
+		fn: `
+		func $(s []int) int {
+			n := 0
+			for i := 0; i < len(s); i++ {
+				n += s[i]
+			}
+			return n
+		}
+		`,
+		neg: []string{"panicindex", "(?s)\tCMPQ\t.*\tCMPQ\t"},
+	},
+	{
+		// s shrinks between the test and the index, so the check stays.
+		fn: `
+		func $(s []int) int {
+			n := 0
+			for i := 0; i < len(s); i++ {
+				s = s[1:]
+				n += s[i]
+			}
+			return n
+		}
+		`,
+		pos: []string{"panicindex"},
+	},
+	// -B removes the bounds check that is there by default.
+	{
+		fn: `
+		func $(a []int, i int) int {
+			return a[i]
+		}
+		`,
+		variants: []asmVariant{
+			{pos: []string{"\tCALL\truntime.panicindex\\(SB\\)"}},
+			{flags: []string{"-B"}, neg: []string{"panicindex", "\tCMPQ\t"}},
+		},
+	},
+	// Bounds check elimination.
+	{
+		fn: `
+		func $(a []int) {
+			for i := range a {
+				a[i] = i
+			}
+		}
+		`,
+		noBCE: true,
+	},
+	{
+		fn: `
+		func $(a []int) int {
+			n := 0
+			for i := range a {
+				n += a[i]
+			}
+			return n
+		}
+		`,
+		noBCE: true,
+	},
+	{
+		fn: `
+		func $(a []int, i int) int {
+			if i >= 0 && i < len(a) {
+				return a[i]
+			}
+			return 0
+		}
+		`,
+		noBCE: true,
+	},
+	{
+		fn: `
+		func $(a []int) int {
+			if len(a) > 0 {
+				return a[len(a)-1]
+			}
+			return 0
+		}
+		`,
+		noBCE: true,
+	},
+	{
+		fn: `
+		func $(a *[8]int, i int) int {
+			return a[i&7]
+		}
+		`,
+		noBCE: true,
+	},
+	{
+		fn: `
+		func $(a [16]byte, i uint8) byte {
+			return a[i>>4]
+		}
+		`,
+		noBCE: true,
+	},
+	{
+		fn: `
+		func $(s string) string {
+			for i := 0; i < len(s); i++ {
+				if s[i] == '/' {
+					return s[i+1:]
+				}
+			}
+			return s
+		}
+		`,
+		noBCE: true,
+	},
+	// The parity of a byte is in the parity flag after TESTB; no
+	// table load or POPCNT needed.
+	{
+		fn: `
+		func $(x uint8) int {
+			return bits.OnesCount8(x) % 2
+		}
+		`,
+		pos: []string{"\tTESTB\t", "\tSETPC\t"},
+		neg: []string{"POPCNT", "oneBitCount"},
+	},
+	{
+		fn: `
+		func $(x uint32) bool {
+			return bits.OnesCount8(uint8(x>>8))&1 == 0
+		}
+		`,
+		pos: []string{"\tTESTB\t", "\tSETPS\t"},
+		neg: []string{"POPCNT", "oneBitCount", "SETPC"},
+	},
+	{
+		fn: `
+		func $(x uint8, y int) int {
+			if bits.OnesCount8(x)&1 != 0 {
+				return y
+			}
+			return 0
+		}
+		`,
+		pos: []string{"\tTESTB\t", "\tJPS\t"},
+		neg: []string{"POPCNT", "oneBitCount", "SETPC"},
+	},
+	{
+		fn: `
+		func $(x uint8) int {
+			return bits.OnesCount8(x)
+		}
+		`,
+		pos: []string{"\tLEAQ\truntime\\.oneBitCount\\(SB\\)"},
+		neg: []string{"POPCNT", "CALL"},
+	},
+	// The XOR-folding idioms for parity leave it in bit 0 once the
+	// low byte has been folded by 4, 2 and 1, in any order.
+	{
+		fn: `
+		func $(x uint8) uint8 {
+			x ^= x >> 4
+			x ^= x >> 2
+			x ^= x >> 1
+			return x & 1
+		}
+		`,
+		pos: []string{"\tTESTB\t", "\tSETPC\t"},
+		neg: []string{"SHRB", "XORL"},
+	},
+	{
+		fn: `
+		func $(x uint16) uint16 {
+			x ^= x >> 1
+			x ^= x >> 4
+			x ^= x >> 2
+			return x & 1
+		}
+		`,
+		pos: []string{"\tTESTB\t", "\tSETPC\t"},
+		neg: []string{"SHRW", "XORL"},
+	},
+	{
+		fn: `
+		func $(x uint32) uint32 {
+			x ^= x >> 16
+			x ^= x >> 8
+			x ^= x >> 4
+			x ^= x >> 2
+			x ^= x >> 1
+			return x & 1
+		}
+		`,
+		pos: []string{"\tSHRL\t\\$16,", "\tSHRL\t\\$8,", "\tTESTB\t", "\tSETPC\t"},
+		neg: []string{"SHRL\t\\$4,", "SHRL\t\\$2,", "SHRL\t\\$1,"},
+	},
+	{
+		fn: `
+		func $(x uint64) uint64 {
+			x ^= x >> 32
+			x ^= x >> 16
+			x ^= x >> 8
+			x ^= x >> 4
+			x ^= x >> 2
+			x ^= x >> 1
+			return x & 1
+		}
+		`,
+		pos: []string{"\tSHRQ\t\\$8,", "\tTESTB\t", "\tSETPC\t"},
+		neg: []string{"SHRQ\t\\$4,", "SHRQ\t\\$2,", "SHRQ\t\\$1,"},
+	},
+	// Shifts other than 4, 2 and 1 do not fold the whole byte.
+	{
+		fn: `
+		func $(x uint64) uint64 {
+			x ^= x >> 1
+			x ^= x >> 2
+			x ^= x >> 3
+			return x & 1
+		}
+		`,
+		pos: []string{"\tSHRQ\t\\$3,", "\tANDQ\t\\$1,"},
+		neg: []string{"SETPC"},
+	},
+	// Iterating over set bits: x != 0 makes the zero case of
+	// TrailingZeros64 dead, so the body is one BSFQ and LEAQ/ANDQ to
+	// clear the lowest bit.
+	{
+		fn: `
+		func $(x uint64) int {
+			n := 0
+			for x != 0 {
+				n += bits.TrailingZeros64(x)
+				x &= x - 1
+			}
+			return n
+		}
+		`,
+		pos: []string{"\tBSFQ\t", "\tLEAQ\t-1\\("},
+		neg: []string{"CMOVQEQ", "(?s)\tBSFQ\t.*\tBSFQ\t"},
+	},
+	// Choosing between a pointer and nil is a CMOVQ. Either value is
+	// a valid pointer, so the result is safe to scan as one.
+	{
+		fn: `
+		func $(c bool, p *int) *int {
+			var r *int
+			if c {
+				r = p
+			}
+			return r
+		}
+		`,
+		pos: []string{"\tCMOVQNE\t"},
+		neg: []string{"\tJ(EQ|NE)\t"},
+	},
+	{
+		fn: `
+		func $(x, y int, p *int) *int {
+			var r *int
+			if x < y {
+				r = p
+			}
+			return r
+		}
+		`,
+		pos: []string{"\tCMOVQLT\t"},
+		neg: []string{"\tJ(LT|GE)\t"},
+	},
+	// A conditional assignment of either of two values is a CMOV of
+	// the width of the values, with no branch.
+	{
+		fn: `
+		func $(c bool, a, b int) int {
+			var x int
+			if c {
+				x = a
+			} else {
+				x = b
+			}
+			return x
+		}
+		`,
+		pos: []string{"\tCMOVQNE\t"},
+		neg: []string{"\tJ(EQ|NE)\t"},
+	},
+	{
+		fn: `
+		func $(c bool, a, b int32) int32 {
+			var x int32
+			if c {
+				x = a
+			} else {
+				x = b
+			}
+			return x
+		}
+		`,
+		pos: []string{"\tCMOVLNE\t"},
+		neg: []string{"\tJ(EQ|NE)\t", "\tCMOVQ"},
+	},
+	// So are min and max, when written as an assignment.
+	{
+		fn: `
+		func $(a, b int) int {
+			m := b
+			if a < b {
+				m = a
+			}
+			return m
+		}
+		`,
+		pos: []string{"\tCMPQ\t", "\tCMOVQLT\t"},
+		neg: []string{"\tJ[A-Z]+\t"},
+	},
+	{
+		fn: `
+		func $(a, b uint32) uint32 {
+			m := b
+			if a > b {
+				m = a
+			}
+			return m
+		}
+		`,
+		pos: []string{"\tCMPL\t", "\tCMOVLHI\t"},
+		neg: []string{"\tJ[A-Z]+\t", "\tCMOVQ"},
+	},
+	// A branch with a side effect, here a store, keeps its branch.
+	// So does one with a load, which may fault.
+	{
+		decls: `var cmovCount int`,
+		fn: `
+		func $(c bool, a, b int) int {
+			x := b
+			if c {
+				x = a
+				cmovCount++
+			}
+			return x
+		}
+		`,
+		pos: []string{"\tJ(EQ|NE)\t", "\tINCQ\t\"\"\\.cmovCount\\(SB\\)"},
+		neg: []string{"\tCMOV"},
+	},
+	{
+		fn: `
+		func $(c bool, p *int, b int) int {
+			x := b
+			if c {
+				x = *p
+			}
+			return x
+		}
+		`,
+		pos: []string{"\tJ(EQ|NE)\t"},
+		neg: []string{"\tCMOV"},
+	},
+	// Defaulting an empty string selects the pointer and the length
+	// of the constant with a CMOVQ each, on the flags of one TESTQ of
+	// the length. An empty s keeps its own pointer, whatever it is:
+	// with a length of 0 it is still a valid string.
+	{
+		fn: `
+		func $(s string) string {
+			if s == "" {
+				s = "default"
+			}
+			return s
+		}
+		`,
+		pos:    []string{"\tTESTQ\t", "\tLEAQ\tgo.string.\"default\"\\(SB\\), ", "\tMOVL\t\\$7, "},
+		neg:    []string{"\tJ[A-Z]+\t"},
+		counts: map[string]int{"\tCMOVQEQ\t": 2, "\tTESTQ\t": 1},
+	},
+	{
+		fn: `
+		func $(p *struct{ name string }) string {
+			n := p.name
+			if len(n) == 0 {
+				n = "default"
+			}
+			return n
+		}
+		`,
+		neg:    []string{"\tJ[A-Z]+\t"},
+		counts: map[string]int{"\tCMOVQEQ\t": 2},
+	},
+	// Even/odd tests only look at the low byte. Signed x%2 == 0 is
+	// the same test: the remainder of a negative x is -1 or 0.
+	{
+		fn: `
+		func $(x int) bool {
+			return x%2 == 0
+		}
+		`,
+		pos: []string{"\tTESTB\t\\$1, "},
+		neg: []string{"ANDQ", "SARQ", "BT"},
+	},
+	{
+		fn: `
+		func $(x uint) bool {
+			return x&1 == 0
+		}
+		`,
+		pos: []string{"\tTESTB\t\\$1, "},
+		neg: []string{"ANDQ", "BT"},
+	},
+	{
+		fn: `
+		func $(x int32) bool {
+			return x%8 != 0
+		}
+		`,
+		pos: []string{"\tTESTB\t\\$7, "},
+		neg: []string{"ANDL", "SARL"},
+	},
+	// Swapping two values is only a renaming in SSA form; no moves
+	// between registers are needed.
+	{
+		fn: `
+		func $(a, b int) int {
+			a, b = b, a
+			return a<<1 - b
+		}
+		`,
+		neg:     []string{"XCHGQ", "(?m)\tMOVQ\t[A-Z][A-Z0-9]*, [A-Z][A-Z0-9]*$"},
+		maxGPRs: 2,
+	},
+	{
+		// XCHGQ with a memory operand is implicitly locked, so a
+		// swap through memory uses two loads and two stores.
+		fn: `
+		func $(p, q *int) {
+			*p, *q = *q, *p
+		}
+		`,
+		pos: []string{"(?s)\tMOVQ\t\\([A-Z0-9]+\\), [A-Z0-9]+\n.*\tMOVQ\t\\([A-Z0-9]+\\), [A-Z0-9]+\n"},
+		neg: []string{"XCHGQ", "autotmp"},
+	},
+	{
+		// a small frame fits in the nosplit limit without a stack check
+		fn: `
+		func $(a, b int) int {
+			var buf [8]int
+			buf[a&7] = b
+			return buf[b&7]
+		}
+		`,
+		nosplit: true,
+	},
+	// a frame larger than StackBig is set up only after its stack check
+	{
+		fn: `
+		func $(a, b int) int {
+			var buf [8192]int
+			buf[a&8191] = b
+			return buf[b&8191]
+		}
+		`,
+		pos:             []string{"\tCALL\truntime.morestack"},
+		stackCheckFirst: true,
+	},
+	// The stack check of an executable loads g with the local-exec
+	// TLS model. See linuxAMD64SharedTests for -shared.
+	{
+		fn: `
+		func $(x int) {
+			g(x)
+		}
+		func g(int)
+		`,
+		pos:    []string{"\tMOVQ\t\\(TLS\\), CX"},
+		relocs: []string{"t=R_TLS_LE TLS\\+0"},
+	},
+	// Check that a counter incremented while a comparison is live
+	// uses LEAQ, so the comparison need not be recomputed.
+	{
+		fn: `
+		func $(x, y, n int) int {
+			r := 0
+			for i := 0; i < n; i++ {
+				if x < y {
+					r = i
+				}
+				r++
+				if x < y {
+					break
+				}
+			}
+			return r
+		}
+		`,
+		pos: []string{"\tLEAQ\t1\\("},
+		neg: []string{"(?s)CMOVQLT.*CMPQ"},
+	},
+	// Without -race, a store to a global is not instrumented; see
+	// linuxAMD64RaceTests for the instrumented version.
+	{
+		fn: `
+		var noRaceX int
+
+		func $(v int) {
+			noRaceX = v
+		}
+		`,
+		pos: []string{"\tMOVQ\t[A-Z0-9]+, \"\"\\.noRaceX\\(SB\\)"},
+		neg: []string{"racewrite", "racefuncenter"},
+	},
+	// Without -msan, accesses through unsafe pointers are not
+	// instrumented; see linuxAMD64MsanTests.
+	{
+		fn: `
+		func $(p unsafe.Pointer, v int) int {
+			x := *(*int)(p)
+			*(*int)(p) = v
+			return x
+		}
+		`,
+		neg: []string{"msanread", "msanwrite"},
+	},
+	// The length of a constant string and its bytes at constant
+	// indexes are constants. Out of range constant indexes are
+	// rejected by the type checker (see test/fixedbugs/issue4232.go).
+	{
+		fn: `
+		func $() (int, byte) {
+			return len("hello"), "hello"[2]
+		}
+		`,
+		pos: []string{"\tMOVQ\t\\$5, ", "\tMOVB\t\\$108, "},
+		neg: []string{"go\\.string\\."},
+	},
+	// Check that map accesses with int64 and string keys use the
+	// specialized runtime helpers.
+	{
+		fn: `
+		func $(m map[int]int, k int) int {
+			return m[k]
+		}
+		`,
+		pos:      []string{"\tCALL\truntime\\.mapaccess1_fast64\\(SB\\)"},
+		negCalls: []string{"runtime.mapaccess1"},
+	},
+	{
+		fn: `
+		func $(m map[string]int, k string) int {
+			return m[k]
+		}
+		`,
+		pos:      []string{"\tCALL\truntime\\.mapaccess1_faststr\\(SB\\)"},
+		negCalls: []string{"runtime.mapaccess1"},
+	},
+	// A byte extracted from memory by a shift is loaded directly.
+	// From a register it is shifted rather than moved from AH.
+	{
+		fn: `
+		func $(p *uint64, x uint64) (byte, byte) {
+			return byte(*p >> 16), byte(x >> 8)
+		}
+		`,
+		pos: []string{"\tMOVBLZX\t2\\([A-Z0-9]+\\), ", "\tSHRQ\t\\$8, "},
+		neg: []string{"\tSHRQ\t\\$16, ", "AH"},
+	},
+	// Comparing one field of two structs loads just that field, not
+	// the whole struct. Comparing whole structs is a separate path.
+	{
+		fn: `
+		type fieldCmp struct {
+			a, x, b int64
+			s       [4]int64
+		}
+
+		func $(a, b *fieldCmp) bool {
+			return a.x == b.x
+		}
+		`,
+		pos:      []string{"\tMOVQ\t8\\([A-Z0-9]+\\), ", "\tCMPQ\t8\\([A-Z0-9]+\\), "},
+		neg:      []string{"(?s)CMPQ.*CMPQ", "MOVUPS", "DUFFCOPY"},
+		negCalls: []string{"runtime.memequal"},
+	},
+	// Comparing 32 bytes of memory uses two 16 byte SSE compares.
+	{
+		fn: `
+		type sseCmp struct {
+			a, b, c, d int64
+		}
+
+		func $(a, b *sseCmp) bool {
+			return *a == *b
+		}
+		`,
+		pos:      []string{"\tMOVUPS\t\\([A-Z0-9]+\\), X", "\tPCMPEQB\tX", "\tPMOVMSKB\tX"},
+		neg:      []string{"\tCMPQ\t"},
+		counts:   map[string]int{"\tPCMPEQB\t": 2},
+		negCalls: []string{"runtime.memequal"},
+	},
+	// Padding is not compared, so a struct with padding is still
+	// compared field by field.
+	{
+		fn: `
+		type paddedCmp struct {
+			a int8
+			b int64
+			c int32
+			d int64
+		}
+
+		func $(a, b *paddedCmp) bool {
+			return *a == *b
+		}
+		`,
+		pos: []string{"\tCMPB\t", "\tCMPQ\t"},
+		neg: []string{"PCMPEQB"},
+	},
+	// A hash-combine step, rotate then xor, is one ROLQ and one XORQ.
+	{
+		fn: `
+		func $(h, x uint64) uint64 {
+			return (h<<13 | h>>(64-13)) ^ x
+		}
+		`,
+		pos: []string{"\tROLQ\t\\$13, ", "\tXORQ\t"},
+		neg: []string{"SHLQ", "SHRQ", "\tORQ\t", "(?s)ROLQ.*ROLQ", "(?s)XORQ.*XORQ"},
+	},
+	// The first element is loaded before the multiply that uses it,
+	// and the second is added to the product from memory.
+	{
+		fn: `
+		func $(p *[2]int, x int) int {
+			return p[0]*x + p[1]
+		}
+		`,
+		posOrdered: []string{"\tMOVQ\t\\([A-Z]+\\), ", "\tIMULQ\t", "\tADDQ\t8\\([A-Z]+\\), "},
+	},
+	// Multiplication by 96 is a LEAQ and a shift, and nothing else.
+	{
+		fn: `
+		func $(x int) int {
+			return x * 96
+		}
+		`,
+		golden: "amd64_mul96.golden",
+	},
+	// A closure that only reads its captured variable.
+	{
+		fn: `
+		func $(x int) func() int {
+			return func() int { return x + 1 }
+		}
+		`,
+		closures: true,
+		golden:   "amd64_closure.golden",
+	},
+	// Reducing a slice of bools with && or || accumulates the bytes
+	// with ANDL or ORL, with no branch in the loop besides its
+	// condition.
+	{
+		fn: `
+		func $(s []bool) bool {
+			all := true
+			for _, b := range s {
+				all = all && b
+			}
+			return all
+		}
+		`,
+		pos: []string{"\tMOVBLZX\t\\([A-Z]+\\)\\([A-Z]+\\*1\\), [A-Z]+\n.*\tINCQ\t[A-Z]+\n.*\tANDL\t[A-Z]+, [A-Z]+\n.*\tCMPQ\t[A-Z]+, [A-Z]+\n.*\tJLT\t"},
+		neg: []string{"\tJ(EQ|NE)\t", "\tTESTB\t", "\tCMPB\t"},
+	},
+	{
+		fn: `
+		func $(s []bool) bool {
+			any := false
+			for _, b := range s {
+				any = any || b
+			}
+			return any
+		}
+		`,
+		pos: []string{"\tMOVBLZX\t\\([A-Z]+\\)\\([A-Z]+\\*1\\), [A-Z]+\n.*\tINCQ\t[A-Z]+\n.*\tORL\t[A-Z]+, [A-Z]+\n.*\tCMPQ\t[A-Z]+, [A-Z]+\n.*\tJLT\t"},
+		neg: []string{"\tJ(EQ|NE)\t", "\tTESTB\t", "\tCMPB\t"},
+	},
+	// Written with an early exit, the loop stops at the first false
+	// element, which it compares in memory, and returns false there.
+	{
+		fn: `
+		func $(s []bool) bool {
+			for _, b := range s {
+				if !b {
+					return false
+				}
+			}
+			return true
+		}
+		`,
+		pos: []string{"\tCMPB\t\\([A-Z]+\\), \\$0\n.*\tJNE\t.*\n.*\tMOVB\t\\$0, ", "\tMOVB\t\\$1, "},
+	},
+	// Masking to the low 8, 16 or 32 bits is a zero-extending move,
+	// with no immediate.
+	{
+		fn: `
+		func $(x, y, z uint64) (uint64, uint64, uint64) {
+			return x & 0xffffffff, y & 0xff, z & 0xffff
+		}
+		`,
+		pos: []string{"\tMOVL\t[A-Z]+, [A-Z]+\n", "\tMOVBLZX\t[A-Z]L, ", "\tMOVWLZX\t"},
+		neg: []string{"\tANDQ\t", "\tANDL\t"},
+	},
+	// The mask is a zero extension, not a sign extension, for signed
+	// operands too.
+	{
+		fn: `
+		func $(x int64) int64 {
+			return x & 0xffffffff
+		}
+		`,
+		pos: []string{"\tMOVL\t[A-Z]+, [A-Z]+\n"},
+		neg: []string{"\tANDQ\t", "MOVLQSX"},
+	},
+	// Rotates by constants and by masked variable counts.
+	{
+		fn: `
+		func $(x uint32) uint32 {
+			return x<<7 | x>>25
+		}
+		`,
+		pos: []string{"\tROLL\t\\$7, "},
+		neg: []string{"SHLL", "SHRL", "\tORL\t"},
+	},
+	{
+		fn: `
+		func $(x uint64) uint64 {
+			return x>>7 | x<<57
+		}
+		`,
+		pos: []string{"\tROLQ\t\\$57, "},
+		neg: []string{"SHLQ", "SHRQ", "\tORQ\t"},
+	},
+	{
+		fn: `
+		func $(x uint32, n uint) uint32 {
+			return x<<(n&31) | x>>(32-n&31)
+		}
+		`,
+		pos: []string{"\tROLL\t[A-Z]+, "},
+		neg: []string{"SHLL", "SHRL", "\tORL\t"},
+	},
+	{
+		fn: `
+		func $(x uint64, n uint) uint64 {
+			return x<<(n&63) | x>>(64-(n&63))
+		}
+		`,
+		pos: []string{"\tROLQ\t[A-Z]+, "},
+		neg: []string{"SHLQ", "SHRQ", "\tORQ\t"},
+	},
+	{
+		fn: `
+		func $(x uint32, n uint) uint32 {
+			return x>>(n&31) | x<<(32-n&31)
+		}
+		`,
+		pos: []string{"\tRORL\t[A-Z]+, "},
+		neg: []string{"SHLL", "SHRL", "\tORL\t"},
+	},
+	{
+		fn: `
+		func $(x uint64, n uint) uint64 {
+			return x>>(n&63) | x<<(64-n&63)
+		}
+		`,
+		pos: []string{"\tRORQ\t[A-Z]+, "},
+		neg: []string{"SHLQ", "SHRQ", "\tORQ\t"},
+	},
+	// Without the masks, shifting by n >= 33 gives 0, so this is not
+	// a rotate.
+	{
+		fn: `
+		func $(x uint32, n uint) uint32 {
+			return x<<n | x>>(32-n)
+		}
+		`,
+		pos: []string{"\tSHLL\t", "\tSHRL\t"},
+		neg: []string{"\tRO[LR]L\t"},
+	},
+	// math.Copysign is done with bit operations on the sign bit, in
+	// X registers.
+	{
+		fn: `
+		func $(x float64) float64 {
+			return math.Copysign(1.0, x)
+		}
+		`,
+		pos: []string{"\tANDPD\t", "\tORPD\t"},
+		neg: []string{"CALL", "\tJ"},
+	},
+	// A small leaf function needs no frame and no stack check; its
+	// only frame instruction is the RET.
+	{
+		fn: `
+		func $(a, b int) int {
+			return a*3 + b
+		}
+		`,
+		maxFrameInsts: 1,
+	},
+	// Branches on constant conditions are removed entirely,
+	// including through && and || with a non-constant operand.
+	{
+		fn: `
+		func $(b bool) int {
+			const c = false
+			if false && b {
+				runtime.GC()
+			}
+			if b && c {
+				runtime.GC()
+			}
+			if !(b || !c) {
+				runtime.GC()
+			}
+			return 1
+		}
+		`,
+		neg:      []string{"\tJ"},
+		negCalls: []string{"runtime.GC"},
+	},
+	// A condition with side effects is still evaluated.
+	{
+		fn: `
+		func $() {
+			const c = false
+			if runtime.NumGoroutine() > 1 && c {
+				runtime.GC()
+			}
+		}
+		`,
+		pos:      []string{"\tCALL\truntime\\.NumGoroutine\\(SB\\)"},
+		negCalls: []string{"runtime.GC"},
+	},
+	// The parallel assignment is a shuffle of the loop's phis, which
+	// needs no more than one move per variable.
+	{
+		fn: `
+		func $(n, x, y, z int) int {
+			for i := 0; i < n; i++ {
+				x, y, z = y, z, x+1
+			}
+			return x*100 + y*10 + z
+		}
+		`,
+		noCopyChains: true,
+	},
+	{
+		fn: `
+		func $(n int, x, y, z float64) float64 {
+			for i := 0; i < n; i++ {
+				x, y, z = y, z, x*2
+			}
+			return x + y*z
+		}
+		`,
+		noCopyChains: true,
+	},
+	// Clamping to [0, 1] is a MAXSD and a MINSD, with no branches.
+	{
+		fn: `
+		func $(a, b, t float64) float64 {
+			if t < 0 {
+				t = 0
+			}
+			if t > 1 {
+				t = 1
+			}
+			return a + (b-a)*t
+		}
+		`,
+		pos: []string{"\tMAXSD\t", "\tMINSD\t"},
+		neg: []string{"\tJ", "UCOMISD"},
+	},
+	// A choice between two float constants selects between their bits,
+	// each materialized once, and moves the result to an SSE register.
+	// The bool is zero-extended before it is tested.
+	{
+		fn: `
+		func $(c bool, y float64) float64 {
+			x := 0.0
+			if c {
+				x = 1.0
+			}
+			return x * y
+		}
+		`,
+		pos:    []string{"\tMOVBLZX\t", "\tCMOVQNE\t", "\tMOVQ\t[A-Z0-9]+, X[0-9]+"},
+		neg:    []string{"\tJ", "\tMOVSD\t\\$"},
+		counts: map[string]int{"\\$4607182418800017408, ": 1},
+	},
+	{
+		fn: `
+		func $(a, b int) float32 {
+			x := float32(2.5)
+			if a < b {
+				x = -1
+			}
+			return x
+		}
+		`,
+		pos: []string{"\tCMOVLLT\t", "\tMOVL\t\\$1075838976, ", "\tMOVL\t\\$-1082130432, "},
+		neg: []string{"\tJ", "\tMOVSS\t\\$"},
+	},
+	// Multiplications by constants are lowered to no more
+	// LEAQs and shifts than needed.
+	{
+		fn: `
+		func $(x int) int {
+			return x * 25
+		}
+		`,
+		counts: map[string]int{"\tLEAQ\t": 2, "\tSHLQ\t": 0, "IMUL": 0},
+	},
+	{
+		fn: `
+		func $(x int) int {
+			return x * 40
+		}
+		`,
+		counts: map[string]int{"\tLEAQ\t": 1, "\tSHLQ\t": 1, "IMUL": 0},
+	},
+	// A closure captures the variables it assigns to by reference.
+	// If the closure does not escape, neither the closure nor the
+	// captured variables need to be allocated on the heap.
+	{
+		fn: `
+		func $(s []int) int {
+			n := 0
+			applyClosure(s, func(v int) { n += v })
+			return n
+		}
+		func applyClosure(s []int, f func(int)) {
+			for _, v := range s {
+				f(v)
+			}
+		}
+		`,
+		pos:      []string{"NEEDCTXT", "\tMOVQ\t8\\(DX\\), "},
+		negCalls: []string{"runtime.newobject"},
+		closures: true,
+	},
+	// If the closure escapes, so do the variables it captures by
+	// reference, and both are allocated on the heap.
+	{
+		fn: `
+		func $(x int) func() int {
+			n := 0
+			return func() int {
+				n += x
+				return n
+			}
+		}
+		`,
+		counts:   map[string]int{"\tCALL\truntime\\.newobject\\(SB\\)": 2},
+		closures: true,
+	},
+	// The same call is inlined by default, but not with -l, and
+	// its arguments are spilled to the stack with -N.
+	{
+		fn: `
+		func addInl(a, b int) int {
+			return a + b
+		}
+		func $(x int) int {
+			return addInl(x, 1) * 64
+		}
+		`,
+		neg: []string{"\tCALL\t"},
+	},
+	{
+		fn: `
+		func addNoInl(a, b int) int {
+			return a + b
+		}
+		func $(x int) int {
+			return addNoInl(x, 1) * 64
+		}
+		`,
+		flags: []string{"-l"},
+		pos:   []string{"\tCALL\t\"\"\\.addNoInl\\(SB\\)"},
+	},
+	{
+		fn: `
+		func addNoOpt(a, b int) int {
+			return a + b
+		}
+		func $(x int) int {
+			return addNoOpt(x, 1) * 64
+		}
+		`,
+		flags: []string{"-N"},
+		pos:   []string{"\tMOVQ\t\\$1, \"\"\\.b\\+[0-9]+\\(SP\\)"},
+		neg:   []string{"\tCALL\t\"\"\\.addNoOpt\\(SB\\)", "\tINCQ\t"},
+	},
+	// A defer in a loop may run any number of times, so each one
+	// is recorded with runtime.deferproc and run by deferreturn.
+	{
+		fn: `
+		func $(n int) {
+			for i := 0; i < n; i++ {
+				defer runtime.GC()
+			}
+		}
+		`,
+		pos: []string{"\tCALL\truntime\\.deferproc\\(SB\\)", "\tCALL\truntime\\.deferreturn\\(SB\\)"},
+	},
+	// A function with a loop is not inlined, while a small
+	// function is inlined and still compiled on its own.
+	{
+		fn: `
+		func $(s []int) int {
+			return $sum(s) + $double(len(s))
+		}
+		func $sum(s []int) (n int) {
+			for _, v := range s {
+				n += v
+			}
+			return n
+		}
+		func $double(x int) int {
+			return x * 2
+		}
+		`,
+		pos:     []string{"\tCALL\t\"\"\\.f\\d+_amd64_sum\\(SB\\)"},
+		neg:     []string{"_double\\(SB\\)"},
+		funcPos: map[string][]string{"$sum": {"\tADDQ\t"}, "$double": {"\tSHLQ\t\\$1, "}},
+		funcNeg: map[string][]string{"$sum": {"\tCALL\t"}},
+	},
+	// A small function is inlined into its caller: the caller has
+	// the callee's rotate, and no call to it. The callee is still
+	// compiled on its own.
+	{
+		fn: `
+		func $(x uint32) uint32 {
+			return $rotate(x) ^ 1
+		}
+		func $rotate(x uint32) uint32 {
+			return x<<7 | x>>25
+		}
+		`,
+		pos:     []string{"\tROLL\t\\$7, "},
+		inlined: []string{"$rotate"},
+		funcPos: map[string][]string{"$rotate": {"\tROLL\t\\$7, "}},
+	},
+	// Multiplying the loop counter by a loop invariant is
+	// strength reduced to adding the invariant to an accumulator.
+	// The accumulator starts at 0*stride, so no multiply is left
+	// before the loop either.
+	{
+		fn: `
+		func $(a []int, n, stride int) int {
+			s := 0
+			for i := 0; i < n; i++ {
+				s += a[i*stride]
+			}
+			return s
+		}
+		`,
+		neg: []string{"IMULQ"},
+	},
+	// Slicing checks each index against the next one with a single
+	// compare, and all the checks share one call to panicslice.
+	{
+		fn: `
+		func $(s []int, a, b, c int) []int {
+			return s[a:b:c]
+		}
+		`,
+		counts: map[string]int{"\tCMPQ\t": 3, "\tCALL\truntime\\.panicslice\\(SB\\)": 1},
+	},
+	{
+		fn: `
+		func $(s []int, a, b int) []int {
+			return s[a:b]
+		}
+		`,
+		counts: map[string]int{"\tCMPQ\t": 2, "\tCALL\truntime\\.panicslice\\(SB\\)": 1},
+	},
+	// A missing low index is 0, which needs no check, and constant
+	// indexes are checked against each other at compile time.
+	{
+		fn: `
+		func $(s []int, b, c int) []int {
+			return s[:b:c]
+		}
+		`,
+		counts: map[string]int{"\tCMPQ\t": 2, "\tCALL\truntime\\.panicslice\\(SB\\)": 1},
+	},
+	{
+		fn: `
+		func $(s []int) []int {
+			return s[2:8]
+		}
+		`,
+		counts: map[string]int{"\tCMPQ\t": 1, "\tCALL\truntime\\.panicslice\\(SB\\)": 1},
+	},
+	// Constant time comparison, as in crypto/subtle, accumulates the
+	// differences of the bytes with no branch in the loop besides its
+	// condition, and tests the result for zero once, after the loop.
+	// A branch on the data would leak where the inputs differ.
+	{
+		fn: `
+		func $(a, b []byte) bool {
+			b = b[:len(a)]
+			var v byte
+			for i := range a {
+				v |= a[i] ^ b[i]
+			}
+			return v == 0
+		}
+		`,
+		pos:    []string{"\tXORL\t[A-Z0-9]+, [A-Z0-9]+\n.*\tORL\t[A-Z0-9]+, [A-Z0-9]+\n.*\tMOVQ\t[A-Z0-9]+, [A-Z0-9]+\n.*\tCMPQ\t[A-Z0-9]+, [A-Z0-9]+\n.*\tJLT\t"},
+		neg:    []string{"\tJ(EQ|NE)\t", "\tCMPB\t"},
+		counts: map[string]int{"\tTESTB\t": 1, "\tSETEQ\t": 1, "\tJ[A-LN-Z][A-Z]*\t": 2},
+	},
+	// A function with a frame saves the caller's frame pointer and
+	// points BP at the saved copy, unless frame pointers were turned
+	// off with GOEXPERIMENT=noframepointer.
+	{
+		fn: `
+		func $() {
+			runtime.GC()
+		}
+		`,
+		pos: []string{"\tMOVQ\tBP, [0-9]*\\(SP\\)\n.*\tLEAQ\t[0-9]*\\(SP\\), BP\n"},
+		skip: func() string {
+			if !objabi.Framepointer_enabled("linux", "amd64") {
+				return "frame pointers are disabled"
+			}
+			return ""
+		},
+	},
+	// The SWAR population count is not replaced on amd64, since
+	// POPCNTQ may only be used after checking the CPU supports it.
+	{
+		fn: `
+		func $(x uint64) int {
+			x -= (x >> 1) & 0x5555555555555555
+			x = (x & 0x3333333333333333) + ((x >> 2) & 0x3333333333333333)
+			x = (x + (x >> 4)) & 0x0f0f0f0f0f0f0f0f
+			return int((x * 0x0101010101010101) >> 56)
+		}
+		`,
+		pos: []string{"\tIMULQ\t"},
+		neg: []string{"POPCNTQ"},
+	},
+	// Appending a slice grows the destination at most once and copies
+	// the elements with at most one memmove, on whichever path.
+	{
+		fn: `
+		func $(a, b []byte) []byte {
+			return append(a, b...)
+		}
+		`,
+		maxCounts: map[string]int{"\tCALL\truntime\\.memmove\\(SB\\)": 1, "\tCALL\truntime\\.growslice\\(SB\\)": 1},
+	},
+	// bits.OnesCount uses POPCNT only if the CPU supports it, which
+	// is checked at run time, and calls the software version otherwise.
+	{
+		fn: `
+		func $(x uint64) int {
+			return bits.OnesCount64(x)
+		}
+		`,
+		pos: []string{"(?s)\tLEAQ\truntime\\.support_popcnt\\(SB\\), [A-Z]+\n.*\tCMPB\t\\([A-Z]+\\), \\$0\n.*\tJEQ\t.*\tPOPCNTQ\t", "\tCALL\tmath/bits\\.OnesCount64\\(SB\\)"},
+	},
+	{
+		fn: `
+		func $(x uint32) int {
+			return bits.OnesCount32(x)
+		}
+		`,
+		pos: []string{"(?s)\tLEAQ\truntime\\.support_popcnt\\(SB\\), [A-Z]+\n.*\tCMPB\t\\([A-Z]+\\), \\$0\n.*\tJEQ\t.*\tPOPCNTL\t", "\tCALL\tmath/bits\\.OnesCount32\\(SB\\)"},
+	},
+	// LZCNT and TZCNT are not baseline, and the compiler has no
+	// run time check for them, so bits.LeadingZeros64 and
+	// bits.TrailingZeros64 are BSRQ and BSFQ, with a CMOV for zero.
+	{
+		fn: `
+		func $(x uint64) int {
+			return bits.LeadingZeros64(x)
+		}
+		`,
+		pos: []string{"\tBSRQ\t", "\tCMOVQEQ\t"},
+		neg: []string{"LZCNT", "\tCALL\t"},
+	},
+	{
+		fn: `
+		func $(x uint64) int {
+			return bits.TrailingZeros64(x)
+		}
+		`,
+		pos: []string{"\tBSFQ\t", "\tCMOVQEQ\t"},
+		neg: []string{"TZCNT", "\tCALL\t"},
+	},
+	// A sort comparator tests the keys for inequality and order
+	// with the flags of a single compare, and compares the values
+	// only if the keys are equal.
+	{
+		fn: `
+		type sortKV struct {
+			k, v int
+		}
+
+		func $(a, b *sortKV) bool {
+			if a.k != b.k {
+				return a.k < b.k
+			}
+			return a.v < b.v
+		}
+		`,
+		pos:    []string{"(?s)\tCMPQ\t[^\n]*\n[^\n]*\tJEQ\t[^\n]*\n[^\n]*\tSETLT\t"},
+		counts: map[string]int{"\tCMPQ\t": 2, "\tSETLT\t": 2},
+	},
+	// Filling a byte slice with a constant stores 8 copies of it at a
+	// time, leaving only the last few bytes to a loop of MOVBs.
+	{
+		fn: `
+		func $(b []byte) {
+			for i := range b {
+				b[i] = 0x5a
+			}
+		}
+		`,
+		pos:    []string{"\tMOVQ\t\\$6510615555426900570, ", "\tMOVQ\t[A-Z]+, \\([A-Z]+\\)\\([A-Z]+\\*1\\)"},
+		counts: map[string]int{"\tMOVB\t": 1},
+		neg:    []string{"MOVL", "MOVW", "CALL"},
+	},
+	// Filling it with zero calls memclr.
+	{
+		fn: `
+		func $(b []byte) {
+			for i := range b {
+				b[i] = 0
+			}
+		}
+		`,
+		pos: []string{"\tCALL\truntime\\.memclrNoHeapPointers\\(SB\\)"},
+		neg: []string{"\tMOVB\t"},
+	},
+}
+
+// linuxAMD64RaceTests are compiled with -race.
+var linuxAMD64RaceTests = []*asmTest{
+	// Check that functions are bracketed by racefuncenter and
+	// racefuncexit.
+	{
+		fn: `
+		func $(p *int) int {
+			return *p + 1
+		}
+		`,
+		pos: []string{"CALL\truntime\\.racefuncenter\\(SB\\)", "CALL\truntime\\.racefuncexit\\(SB\\)"},
+	},
+	// Check that a store to a global is reported to the race detector.
+	{
+		fn: `
+		var raceX int
+
+		func $(v int) {
+			raceX = v
+		}
+		`,
+		pos: []string{"(?s)LEAQ\t\"\"\\.raceX\\(SB\\), AX\n.*\tCALL\truntime\\.racewrite\\(SB\\)"},
+	},
+}
+
+// linuxAMD64MsanTests are compiled with -msan.
+var linuxAMD64MsanTests = []*asmTest{
+	// Check that the memory sanitizer is told the address and size
+	// of loads and stores through unsafe pointers.
+	{
+		fn: `
+		func $(p unsafe.Pointer) int {
+			return *(*int)(p)
+		}
+		`,
+		pos: []string{"(?s)MOVQ\t\\$8, 8\\(SP\\)\n.*\tCALL\truntime\\.msanread\\(SB\\)"},
+	},
+	{
+		fn: `
+		func $(p unsafe.Pointer, v int) {
+			*(*int)(p) = v
+		}
+		`,
+		pos: []string{"(?s)MOVQ\t\\$8, 8\\(SP\\)\n.*\tCALL\truntime\\.msanwrite\\(SB\\)"},
+	},
+}
+
+var linuxAMD64SharedTests = []*asmTest{
+	// Code that may be in a shared library loads g through the GOT,
+	// with the initial-exec TLS model.
+	{
+		fn: `
+		func $(x int) {
+			g(x)
+		}
+		func g(int)
+		`,
+		pos:    []string{"\tMOVQ\t\\(CX\\)\\(TLS\\*2\\), CX"},
+		relocs: []string{"t=R_TLS_IE "},
+	},
+}
+
+var linux386Tests = []*asmTest{
+	{
+		// check that stack store is optimized away
+		fn: `
+		func $() int {
+			var x int
+			return *(&x)
+		}
+		`,
+		frameSize: 0,
+		argSize:   4,
+	},
+	// Check that len() and cap() div by a constant power of two
+	// are compiled into SHRL.
+	{
+		fn: `
+		func $(a []int) int {
+			return len(a) / 1024
+		}
+		`,
+		pos: []string{"\tSHRL\t\\$10,"},
+	},
+	{
+		fn: `
+		func $(s string) int {
+			return len(s) / (4097 >> 1)
+		}
+		`,
+		pos: []string{"\tSHRL\t\\$11,"},
+	},
+	{
+		fn: `
+		func $(a []int) int {
+			return cap(a) / ((1 << 11) + 2048)
+		}
+		`,
+		pos: []string{"\tSHRL\t\\$12,"},
+	},
+	// Check that len() and cap() mod by a constant power of two
+	// are compiled into ANDL.
+	{
+		fn: `
+		func $(a []int) int {
+			return len(a) % 1024
+		}
+		`,
+		pos: []string{"\tANDL\t\\$1023,"},
+	},
+	{
+		fn: `
+		func $(s string) int {
+			return len(s) % (4097 >> 1)
+		}
+		`,
+		pos: []string{"\tANDL\t\\$2047,"},
+	},
+	{
+		fn: `
+		func $(a []int) int {
+			return cap(a) % ((1 << 11) + 2048)
+		}
+		`,
+		pos: []string{"\tANDL\t\\$4095,"},
+	},
+	{
+		// Test that small memmove was replaced with direct movs
+		fn: `
+                func $() {
+                       x := [...]byte{1, 2, 3, 4, 5, 6, 7}
+                       copy(x[1:], x[:])
+                }
+		`,
+		neg: []string{"memmove"},
+	},
+	{
+		// Same as above but with different size
+		fn: `
+                func $() {
+                       x := [...]byte{1, 2, 3, 4}
+                       copy(x[1:], x[:])
+                }
+		`,
+		neg: []string{"memmove"},
+	},
+	// With the default GO386=sse2, floating point uses SSE2; see
+	// linux386x87Tests for GO386=387.
+	{
+		fn: `
+		func $(x, y float64) float64 {
+			return x + y
+		}
+		`,
+		pos: []string{"\tMOVSD\t", "\tADDSD\t"},
+		neg: []string{"\tFMOVD\t", "\tFADDD"},
+		skip: func() string {
+			if objabi.GO386 != "sse2" {
+				return "GO386 is " + objabi.GO386
+			}
+			return ""
+		},
+	},
+}
+
+var linuxS390XTests = []*asmTest{
+	// Fused multiply-add/sub instructions.
+	{
+		fn: `
+		func f14(x, y, z float64) float64 {
+			return x * y + z
+		}
+		`,
+		pos: []string{"\tFMADD\t"},
+	},
+	{
+		fn: `
+		func f15(x, y, z float64) float64 {
+			return x * y - z
+		}
+		`,
+		pos: []string{"\tFMSUB\t"},
+	},
+	{
+		fn: `
+		func f16(x, y, z float32) float32 {
+			return x * y + z
+		}
+		`,
+		pos: []string{"\tFMADDS\t"},
+	},
+	{
+		fn: `
+		func f17(x, y, z float32) float32 {
+			return x * y - z
+		}
+		`,
+		pos: []string{"\tFMSUBS\t"},
+	},
+	{
+		// check that stack store is optimized away
+		fn: `
+		func $() int {
+			var x int
+			return *(&x)
+		}
+		`,
+		frameSize: 0,
+		argSize:   8,
+	},
+}
+
+var linuxARMTests = []*asmTest{
+	{
+		// make sure assembly output has matching offset and base register.
+		fn: `
+		func f13(a, b int) int {
+			runtime.GC() // use some frame
+			return b
+		}
+		`,
+		pos: []string{"b\\+4\\(FP\\)"},
+	},
+	{
+		// check that stack store is optimized away
+		fn: `
+		func $() int {
+			var x int
+			return *(&x)
+		}
+		`,
+		frameSize: -4,
+		argSize:   4,
+	},
+}
+
+// linux386x87Tests are compiled with GO386=387, which uses x87 floating
+// point instructions instead of SSE2.
+var linux386x87Tests = []*asmTest{
+	{
+		fn: `
+		func $(x, y float64) float64 {
+			return x + y
+		}
+		`,
+		pos: []string{"\tFMOVD\t", "\tFADDDP?\t"},
+		neg: []string{"\tMOVSD\t", "\tADDSD\t"},
+	},
+}
+
+// linuxARMv5Tests are compiled with GOARM=5, which uses software
+// floating point.
+var linuxARMv5Tests = []*asmTest{
+	// Floating point instructions are emulated by runtime._sfloat.
+	{
+		fn: `
+		func $(x, y float64) float64 {
+			return x + y
+		}
+		`,
+		pos: []string{"\tCALL\truntime\\._sfloat\\(SB\\)", "\tADDD\t"},
+	},
+	// Multiply-accumulate is only used with VFP.
+	{
+		fn: `
+		func $(x, y, z float64) float64 {
+			return x*y + z
+		}
+		`,
+		pos: []string{"\tCALL\truntime\\._sfloat\\(SB\\)", "\tMULD\t", "\tADDD\t"},
+		neg: []string{"MULAD"},
+	},
+}
+
+// linuxARMv7Tests are compiled with GOARM=7, which uses VFP floating
+// point instructions.
+var linuxARMv7Tests = []*asmTest{
+	{
+		fn: `
+		func $(x, y float64) float64 {
+			return x + y
+		}
+		`,
+		pos:      []string{"\tADDD\t"},
+		negCalls: []string{"runtime._sfloat"},
+	},
+	// multiply-accumulate
+	{
+		fn: `
+		func $(x, y, z float64) float64 {
+			return x*y + z
+		}
+		`,
+		pos:      []string{"\tMULAD\t"},
+		neg:      []string{"\tMULD\t", "\tADDD\t"},
+		negCalls: []string{"runtime._sfloat"},
+	},
+	{
+		fn: `
+		func $(x, y, z float32) float32 {
+			return z - x*y
+		}
+		`,
+		pos: []string{"\tMULSF\t"},
+		neg: []string{"\tMULF\t", "\tSUBF\t"},
+	},
+}
+
+var linuxARM64Tests = []*asmTest{
+	{
+		fn: `
+		func $(x, y uint32) uint32 {
+			return x &^ y
+		}
+		`,
+		pos: []string{"\tBIC\t"},
+		neg: []string{"\tAND\t"},
+	},
+	{
+		fn: `
+		func $(x, y uint32) uint32 {
+			return x ^ ^y
+		}
+		`,
+		pos: []string{"\tEON\t"},
+		neg: []string{"\tXOR\t"},
+	},
 	{
 		fn: `
-		func f68(a,b [2]byte) bool {
-		    return a == b
-		}`,
-		pos: []string{"\tCMPW\t\"\"[.+_a-z0-9]+\\(SP\\), [A-Z]"},
+		func $(x, y uint64) uint64 {
+			return x ^ ^y
+		}
+		`,
+		pos: []string{"\tEON\t"},
+		neg: []string{"\tXOR\t"},
 	},
 	{
 		fn: `
-		func f69(a,b [3]uint16) bool {
-		    return a == b
-		}`,
-		pos: []string{
-			"\tCMPL\t\"\"[.+_a-z0-9]+\\(SP\\), [A-Z]",
-			"\tCMPW\t\"\"[.+_a-z0-9]+\\(SP\\), [A-Z]",
-		},
+		func $(x, y int64) int64 {
+			return x ^ ^y
+		}
+		`,
+		pos: []string{"\tEON\t"},
+		neg: []string{"\tXOR\t"},
 	},
 	{
 		fn: `
-		func $(a,b [3]int16) bool {
-		    return a == b
-		}`,
-		pos: []string{
-			"\tCMPL\t\"\"[.+_a-z0-9]+\\(SP\\), [A-Z]",
-			"\tCMPW\t\"\"[.+_a-z0-9]+\\(SP\\), [A-Z]",
-		},
+		func $(x, y uint64) uint64 {
+			return ^y ^ x
+		}
+		`,
+		pos: []string{"\tEON\t"},
+		neg: []string{"\tXOR\t"},
 	},
 	{
 		fn: `
-		func $(a,b [12]int8) bool {
-		    return a == b
-		}`,
-		pos: []string{
-			"\tCMPQ\t\"\"[.+_a-z0-9]+\\(SP\\), [A-Z]",
-			"\tCMPL\t\"\"[.+_a-z0-9]+\\(SP\\), [A-Z]",
-		},
+		func $(x, y uint32) uint32 {
+			return x | ^y
+		}
+		`,
+		pos: []string{"\tORN\t"},
+		neg: []string{"\tORR\t"},
 	},
 	{
 		fn: `
-		func f70(a,b [15]byte) bool {
-		    return a == b
-		}`,
-		pos: []string{"\tCMPQ\t\"\"[.+_a-z0-9]+\\(SP\\), [A-Z]"},
+		func $(x, y uint64) uint64 {
+			return x | ^y
+		}
+		`,
+		pos: []string{"\tORN\t"},
+		neg: []string{"\tORR\t"},
 	},
 	{
 		fn: `
-		func f71(a,b unsafe.Pointer) bool { // This was a TODO in mapaccess1_faststr
-		    return *((*[4]byte)(a)) != *((*[4]byte)(b))
-		}`,
-		pos: []string{"\tCMPL\t\\(.*\\), [A-Z]"},
+		func $(x, y int64) int64 {
+			return x | ^y
+		}
+		`,
+		pos: []string{"\tORN\t"},
+		neg: []string{"\tORR\t"},
 	},
 	{
-		// make sure assembly output has matching offset and base register.
 		fn: `
-		func f72(a, b int) int {
-			runtime.GC() // use some frame
-			return b
+		func $(x, y uint64) uint64 {
+			return ^y | x
 		}
 		`,
-		pos: []string{"b\\+24\\(SP\\)"},
+		pos: []string{"\tORN\t"},
+		neg: []string{"\tORR\t"},
 	},
 	{
-		// check load combining
 		fn: `
-		func f73(a, b byte) (byte,byte) {
-		    return f73(f73(a,b))
+		func $(x, y uint64) uint64 {
+			return x & ^y
 		}
 		`,
-		pos: []string{"\tMOVW\t"},
+		pos: []string{"\tBIC\t"},
+		neg: []string{"\tAND\t"},
 	},
 	{
 		fn: `
-		func f74(a, b uint16) (uint16,uint16) {
-		    return f74(f74(a,b))
+		func f34(a uint64) uint64 {
+			return a & ((1<<63)-1)
 		}
 		`,
-		pos: []string{"\tMOVL\t"},
+		pos: []string{"\tAND\t"},
 	},
 	{
 		fn: `
-		func f75(a, b uint32) (uint32,uint32) {
-		    return f75(f75(a,b))
+		func f35(a uint64) uint64 {
+			return a & (1<<63)
 		}
 		`,
-		pos: []string{"\tMOVQ\t"},
+		pos: []string{"\tAND\t"},
 	},
-	// Make sure we don't put pointers in SSE registers across safe points.
 	{
+		// make sure offsets are folded into load and store.
 		fn: `
-		func $(p, q *[2]*int)  {
-		    a, b := p[0], p[1]
-		    runtime.GC()
-		    q[0], q[1] = a, b
+		func f36(_, a [20]byte) (b [20]byte) {
+			b = a
+			return
 		}
 		`,
-		neg: []string{"MOVUPS"},
+		pos: []string{"\tMOVD\t\"\"\\.a\\+[0-9]+\\(FP\\), R[0-9]+", "\tMOVD\tR[0-9]+, \"\"\\.b\\+[0-9]+\\(FP\\)"},
 	},
 	{
 		// check that stack store is optimized away
@@ -567,676 +3978,781 @@ var linuxAMD64Tests = []*asmTest{
 			return *(&x)
 		}
 		`,
-		pos: []string{"TEXT\t.*, [$]0-8"},
+		frameSize: -8,
+		argSize:   8,
 	},
-	// int <-> fp moves
 	{
+		// check that we don't emit comparisons for constant shift
 		fn: `
-		func $(x uint32) bool {
-			return x > 4
+		func $(x int) int {
+			return x << 17
 		}
 		`,
-		pos: []string{"\tSETHI\t.*\\(SP\\)"},
+		pos:     []string{"LSL\t\\$17"},
+		neg:     []string{"CMP"},
+		nosplit: true,
 	},
-	// Check that len() and cap() div by a constant power of two
-	// are compiled into SHRQ.
+	// Shifted operands of comparisons.
 	{
 		fn: `
-		func $(a []int) int {
-			return len(a) / 1024
+		func $(x, y int) bool {
+			return x<<2 < y
 		}
 		`,
-		pos: []string{"\tSHRQ\t\\$10,"},
+		pos: []string{"\tCMP\tR[0-9]+<<2, R[0-9]+"},
+		neg: []string{"\tLSL\t"},
 	},
 	{
+		// The shift is needed anyway, so it is not repeated in the compare.
 		fn: `
-		func $(s string) int {
-			return len(s) / (4097 >> 1)
+		func $(x, y int) (bool, int) {
+			z := x << 2
+			return z < y, z
 		}
 		`,
-		pos: []string{"\tSHRQ\t\\$11,"},
+		pos: []string{"\tLSL\t\\$2,", "\tCMP\tR[0-9]+, R[0-9]+"},
+		neg: []string{"<<2, R"},
 	},
 	{
 		fn: `
-		func $(a []int) int {
-			return cap(a) / ((1 << 11) + 2048)
+		func $(x, y int) bool {
+			return -(x<<2) == y
 		}
 		`,
-		pos: []string{"\tSHRQ\t\\$12,"},
+		pos: []string{"\tCMN\tR[0-9]+<<2, R[0-9]+"},
+		neg: []string{"\tLSL\t", "\tNEG\t", "\tCMP\t"},
 	},
-	// Check that len() and cap() mod by a constant power of two
-	// are compiled into ANDQ.
 	{
 		fn: `
-		func $(a []int) int {
-			return len(a) % 1024
+		func $(x, y int) bool {
+			return y != -(x >> 3)
 		}
 		`,
-		pos: []string{"\tANDQ\t\\$1023,"},
+		pos: []string{"\tCMN\tR[0-9]+->3, R[0-9]+"},
+		neg: []string{"\tASR\t", "\tNEG\t", "\tCMP\t"},
 	},
 	{
+		// As for CMP, a shift that is used again stays out of CMN.
 		fn: `
-		func $(s string) int {
-			return len(s) % (4097 >> 1)
+		func $(x, y int) (bool, int) {
+			z := x << 2
+			return -z == y, z
 		}
 		`,
-		pos: []string{"\tANDQ\t\\$2047,"},
+		pos: []string{"\tLSL\t\\$2,", "\tCMN\tR[0-9]+, R[0-9]+"},
+		neg: []string{"<<2, R", "\tNEG\t"},
 	},
 	{
+		// CMN is only used for equality; x < -y is not x + y < 0.
 		fn: `
-		func $(a []int) int {
-			return cap(a) % ((1 << 11) + 2048)
+		func $(x, y int) bool {
+			return x < -y
 		}
 		`,
-		pos: []string{"\tANDQ\t\\$4095,"},
+		pos: []string{"\tNEG\t", "\tCMP\t"},
+		neg: []string{"\tCMN\t"},
 	},
 	{
-		// Test that small memmove was replaced with direct movs
 		fn: `
-                func $() {
-                       x := [...]byte{1, 2, 3, 4, 5, 6, 7}
-                       copy(x[1:], x[:])
-                }
+		func $(a int32, ptr *int) {
+			if a >= 0 {
+				*ptr = 0
+			}
+		}
 		`,
-		neg: []string{"memmove"},
+		pos: []string{"TBNZ"},
 	},
 	{
-		// Same as above but with different size
 		fn: `
-                func $() {
-                       x := [...]byte{1, 2, 3, 4}
-                       copy(x[1:], x[:])
-                }
+		func $(a int64, ptr *int) {
+			if a >= 0 {
+				*ptr = 0
+			}
+		}
 		`,
-		neg: []string{"memmove"},
+		pos: []string{"TBNZ"},
 	},
 	{
-		// Same as above but with different size
 		fn: `
-                func $() {
-                       x := [...]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
-                       copy(x[1:], x[:])
-                }
+		func $(a int32, ptr *int) {
+			if a < 0 {
+				*ptr = 0
+			}
+		}
 		`,
-		neg: []string{"memmove"},
+		pos: []string{"TBZ"},
 	},
 	{
 		fn: `
-		func $(p int, q *int) bool {
-			return p < *q
+		func $(a int64, ptr *int) {
+			if a < 0 {
+				*ptr = 0
+			}
 		}
 		`,
-		pos: []string{"CMPQ\t\\(.*\\), [A-Z]"},
+		pos: []string{"TBZ"},
 	},
+	// Load-combining tests.
 	{
 		fn: `
-		func $(p *int, q int) bool {
-			return *p < q
+		func $(s []byte) uint16 {
+			return uint16(s[0]) | uint16(s[1]) << 8
 		}
 		`,
-		pos: []string{"CMPQ\t\\(.*\\), [A-Z]"},
+		pos: []string{"\tMOVHU\t\\(R[0-9]+\\)"},
+		neg: []string{"ORR\tR[0-9]+<<8\t"},
 	},
 	{
+		// make sure that CSEL is emitted for conditional moves
 		fn: `
-		func $(p *int) bool {
-			return *p < 7
+		func f37(c int) int {
+		     x := c + 4
+		     if c < 0 {
+		     	x = 182
+		     }
+		     return x
 		}
 		`,
-		pos: []string{"CMPQ\t\\(.*\\), [$]7"},
+		pos: []string{"\tCSEL\t"},
 	},
+	// Check that the absolute difference of two floats is a single FABD.
 	{
 		fn: `
-		func $(p *int) bool {
-			return 7 < *p
+		func $(x, y float64) float64 {
+			return math.Abs(x - y)
 		}
 		`,
-		pos: []string{"CMPQ\t\\(.*\\), [$]7"},
+		pos: []string{"\tFABDD\t"},
+		neg: []string{"\tFSUBD\t", "\tFABSD\t"},
 	},
+	// Negated fused multiply-add/sub instructions.
+	// FNMADD computes -(x*y) - z and FNMSUB computes x*y - z;
+	// -(x*y) + z is z - x*y, which is a plain FMSUB.
 	{
 		fn: `
-		func $(p **int) {
-			*p = nil
+		func $(x, y, z float64) float64 {
+			return -(x * y) - z
 		}
 		`,
-		pos: []string{"CMPL\truntime.writeBarrier\\(SB\\), [$]0"},
+		pos: []string{"\tFNMADDD\t"},
+		neg: []string{"\tFNEGD\t", "\tFNMULD\t", "\tFMULD\t", "\tFSUBD\t"},
 	},
-}
-
-var linux386Tests = []*asmTest{
 	{
-		// check that stack store is optimized away
 		fn: `
-		func $() int {
-			var x int
-			return *(&x)
+		func $(x, y, z float64) float64 {
+			return x * y - z
 		}
 		`,
-		pos: []string{"TEXT\t.*, [$]0-4"},
+		pos: []string{"\tFNMSUBD\t"},
+		neg: []string{"\tFMULD\t", "\tFSUBD\t"},
 	},
-	// Check that len() and cap() div by a constant power of two
-	// are compiled into SHRL.
 	{
 		fn: `
-		func $(a []int) int {
-			return len(a) / 1024
+		func $(x, y, z float32) float32 {
+			return -(x * y) - z
 		}
 		`,
-		pos: []string{"\tSHRL\t\\$10,"},
+		pos: []string{"\tFNMADDS\t"},
+		neg: []string{"\tFNEGS\t", "\tFNMULS\t", "\tFMULS\t", "\tFSUBS\t"},
 	},
 	{
 		fn: `
-		func $(s string) int {
-			return len(s) / (4097 >> 1)
+		func $(x, y, z float32) float32 {
+			return x * y - z
 		}
 		`,
-		pos: []string{"\tSHRL\t\\$11,"},
+		pos: []string{"\tFNMSUBS\t"},
+		neg: []string{"\tFMULS\t", "\tFSUBS\t"},
 	},
 	{
 		fn: `
-		func $(a []int) int {
-			return cap(a) / ((1 << 11) + 2048)
+		func $(x, y, z float64) float64 {
+			return -(x * y) + z
 		}
 		`,
-		pos: []string{"\tSHRL\t\\$12,"},
+		pos: []string{"\tFMSUBD\t"},
+		neg: []string{"\tFNEGD\t", "\tFNMSUBD\t", "\tFNMADDD\t"},
+	},
+	// Check that zero stores are combine into larger stores
+	{
+		fn: `
+		func $(b []byte) {
+			_ = b[1] // early bounds check to guarantee safety of writes below
+			b[0] = 0
+			b[1] = 0
+		}
+		`,
+		pos: []string{"MOVH\tZR"},
+		neg: []string{"MOVB"},
+	},
+	{
+		fn: `
+		func $(b []byte) {
+			_ = b[1] // early bounds check to guarantee safety of writes below
+			b[1] = 0
+			b[0] = 0
+		}
+		`,
+		pos: []string{"MOVH\tZR"},
+		neg: []string{"MOVB"},
+	},
+	{
+		fn: `
+		func $(b []byte) {
+			_ = b[3] // early bounds check to guarantee safety of writes below
+			b[0] = 0
+			b[1] = 0
+			b[2] = 0
+			b[3] = 0
+		}
+		`,
+		pos: []string{"MOVW\tZR"},
+		neg: []string{"MOVB", "MOVH"},
 	},
-	// Check that len() and cap() mod by a constant power of two
-	// are compiled into ANDL.
 	{
 		fn: `
-		func $(a []int) int {
-			return len(a) % 1024
+		func $(b []byte) {
+			_ = b[3] // early bounds check to guarantee safety of writes below
+			b[2] = 0
+			b[3] = 0
+			b[1] = 0
+			b[0] = 0
 		}
 		`,
-		pos: []string{"\tANDL\t\\$1023,"},
+		pos: []string{"MOVW\tZR"},
+		neg: []string{"MOVB", "MOVH"},
 	},
 	{
 		fn: `
-		func $(s string) int {
-			return len(s) % (4097 >> 1)
+		func $(h []uint16) {
+			_ = h[1] // early bounds check to guarantee safety of writes below
+			h[0] = 0
+			h[1] = 0
 		}
 		`,
-		pos: []string{"\tANDL\t\\$2047,"},
+		pos: []string{"MOVW\tZR"},
+		neg: []string{"MOVB", "MOVH"},
 	},
 	{
 		fn: `
-		func $(a []int) int {
-			return cap(a) % ((1 << 11) + 2048)
+		func $(h []uint16) {
+			_ = h[1] // early bounds check to guarantee safety of writes below
+			h[1] = 0
+			h[0] = 0
 		}
 		`,
-		pos: []string{"\tANDL\t\\$4095,"},
+		pos: []string{"MOVW\tZR"},
+		neg: []string{"MOVB", "MOVH"},
 	},
 	{
-		// Test that small memmove was replaced with direct movs
 		fn: `
-                func $() {
-                       x := [...]byte{1, 2, 3, 4, 5, 6, 7}
-                       copy(x[1:], x[:])
-                }
+		func $(b []byte) {
+			_ = b[7] // early bounds check to guarantee safety of writes below
+			b[0] = 0
+			b[1] = 0
+			b[2] = 0
+			b[3] = 0
+			b[4] = 0
+			b[5] = 0
+			b[6] = 0
+			b[7] = 0
+		}
 		`,
-		neg: []string{"memmove"},
+		pos: []string{"MOVD\tZR"},
+		neg: []string{"MOVB", "MOVH", "MOVW"},
 	},
 	{
-		// Same as above but with different size
 		fn: `
-                func $() {
-                       x := [...]byte{1, 2, 3, 4}
-                       copy(x[1:], x[:])
-                }
+		func $(h []uint16) {
+			_ = h[3] // early bounds check to guarantee safety of writes below
+			h[0] = 0
+			h[1] = 0
+			h[2] = 0
+			h[3] = 0
+		}
 		`,
-		neg: []string{"memmove"},
+		pos: []string{"MOVD\tZR"},
+		neg: []string{"MOVB", "MOVH", "MOVW"},
 	},
-}
-
-var linuxS390XTests = []*asmTest{
-	// Fused multiply-add/sub instructions.
 	{
 		fn: `
-		func f14(x, y, z float64) float64 {
-			return x * y + z
+		func $(h []uint16) {
+			_ = h[3] // early bounds check to guarantee safety of writes below
+			h[2] = 0
+			h[3] = 0
+			h[1] = 0
+			h[0] = 0
 		}
 		`,
-		pos: []string{"\tFMADD\t"},
+		pos: []string{"MOVD\tZR"},
+		neg: []string{"MOVB", "MOVH", "MOVW"},
 	},
 	{
 		fn: `
-		func f15(x, y, z float64) float64 {
-			return x * y - z
+		func $(w []uint32) {
+			_ = w[1] // early bounds check to guarantee safety of writes below
+			w[0] = 0
+			w[1] = 0
 		}
 		`,
-		pos: []string{"\tFMSUB\t"},
+		pos: []string{"MOVD\tZR"},
+		neg: []string{"MOVB", "MOVH", "MOVW"},
 	},
 	{
 		fn: `
-		func f16(x, y, z float32) float32 {
-			return x * y + z
+		func $(w []uint32) {
+			_ = w[1] // early bounds check to guarantee safety of writes below
+			w[1] = 0
+			w[0] = 0
 		}
 		`,
-		pos: []string{"\tFMADDS\t"},
+		pos: []string{"MOVD\tZR"},
+		neg: []string{"MOVB", "MOVH", "MOVW"},
 	},
 	{
 		fn: `
-		func f17(x, y, z float32) float32 {
-			return x * y - z
+		func $(b []byte) {
+			_ = b[15] // early bounds check to guarantee safety of writes below
+			b[0] = 0
+			b[1] = 0
+			b[2] = 0
+			b[3] = 0
+			b[4] = 0
+			b[5] = 0
+			b[6] = 0
+			b[7] = 0
+			b[8] = 0
+			b[9] = 0
+			b[10] = 0
+			b[11] = 0
+			b[12] = 0
+			b[13] = 0
+			b[15] = 0
+			b[14] = 0
 		}
 		`,
-		pos: []string{"\tFMSUBS\t"},
+		pos: []string{"STP"},
+		neg: []string{"MOVB", "MOVH", "MOVW"},
 	},
 	{
-		// check that stack store is optimized away
 		fn: `
-		func $() int {
-			var x int
-			return *(&x)
+		func $(h []uint16) {
+			_ = h[7] // early bounds check to guarantee safety of writes below
+			h[0] = 0
+			h[1] = 0
+			h[2] = 0
+			h[3] = 0
+			h[4] = 0
+			h[5] = 0
+			h[6] = 0
+			h[7] = 0
 		}
 		`,
-		pos: []string{"TEXT\t.*, [$]0-8"},
+		pos: []string{"STP"},
+		neg: []string{"MOVB", "MOVH"},
 	},
-}
-
-var linuxARMTests = []*asmTest{
 	{
-		// make sure assembly output has matching offset and base register.
 		fn: `
-		func f13(a, b int) int {
-			runtime.GC() // use some frame
-			return b
+		func $(w []uint32) {
+			_ = w[3] // early bounds check to guarantee safety of writes below
+			w[0] = 0
+			w[1] = 0
+			w[2] = 0
+			w[3] = 0
 		}
 		`,
-		pos: []string{"b\\+4\\(FP\\)"},
+		pos: []string{"STP"},
+		neg: []string{"MOVB", "MOVH"},
 	},
 	{
-		// check that stack store is optimized away
 		fn: `
-		func $() int {
-			var x int
-			return *(&x)
+		func $(w []uint32) {
+			_ = w[3] // early bounds check to guarantee safety of writes below
+			w[1] = 0
+			w[0] = 0
+			w[3] = 0
+			w[2] = 0
 		}
 		`,
-		pos: []string{"TEXT\t.*, [$]-4-4"},
+		pos: []string{"STP"},
+		neg: []string{"MOVB", "MOVH"},
 	},
-}
-
-var linuxARM64Tests = []*asmTest{
 	{
 		fn: `
-		func $(x, y uint32) uint32 {
-			return x &^ y
+		func $(d []uint64) {
+			_ = d[1] // early bounds check to guarantee safety of writes below
+			d[0] = 0
+			d[1] = 0
 		}
 		`,
-		pos: []string{"\tBIC\t"},
-		neg: []string{"\tAND\t"},
+		pos: []string{"STP"},
+		neg: []string{"MOVB", "MOVH"},
 	},
 	{
 		fn: `
-		func $(x, y uint32) uint32 {
-			return x ^ ^y
+		func $(d []uint64) {
+			_ = d[1] // early bounds check to guarantee safety of writes below
+			d[1] = 0
+			d[0] = 0
 		}
 		`,
-		pos: []string{"\tEON\t"},
-		neg: []string{"\tXOR\t"},
+		pos: []string{"STP"},
+		neg: []string{"MOVB", "MOVH"},
 	},
 	{
 		fn: `
-		func $(x, y uint32) uint32 {
-			return x | ^y
+		func $(a *[39]byte) {
+			*a = [39]byte{}
 		}
 		`,
-		pos: []string{"\tORN\t"},
-		neg: []string{"\tORR\t"},
+		pos: []string{"MOVD"},
+		neg: []string{"MOVB", "MOVH", "MOVW"},
 	},
 	{
 		fn: `
-		func f34(a uint64) uint64 {
-			return a & ((1<<63)-1)
+		func $(a *[30]byte) {
+			*a = [30]byte{}
 		}
 		`,
-		pos: []string{"\tAND\t"},
+		pos: []string{"STP"},
+		neg: []string{"MOVB", "MOVH", "MOVW"},
 	},
+	// Large constants are built with one MOVZ or MOVN and a MOVK for
+	// each remaining 16-bit chunk. The listing shows the MOVD before it
+	// is expanded, so count its instructions by the PC of the next one.
 	{
 		fn: `
-		func f35(a uint64) uint64 {
-			return a & (1<<63)
+		func $() uint64 {
+			return 0x1234000000005678
 		}
 		`,
-		pos: []string{"\tAND\t"},
+		pos: []string{"\tMOVD\t\\$1311673391471679096, R[0-9]+\n\t0x0008 "},
 	},
 	{
-		// make sure offsets are folded into load and store.
 		fn: `
-		func f36(_, a [20]byte) (b [20]byte) {
-			b = a
-			return
+		func $() uint64 {
+			return 0xffff123456789abc
 		}
 		`,
-		pos: []string{"\tMOVD\t\"\"\\.a\\+[0-9]+\\(FP\\), R[0-9]+", "\tMOVD\tR[0-9]+, \"\"\\.b\\+[0-9]+\\(FP\\)"},
+		pos: []string{"\tMOVD\t\\$-261458978366788, R[0-9]+\n\t0x000c "},
 	},
 	{
-		// check that stack store is optimized away
 		fn: `
-		func $() int {
-			var x int
-			return *(&x)
+		func $() uint64 {
+			return 0x123456789abcdef0
 		}
 		`,
-		pos: []string{"TEXT\t.*, [$]-8-8"},
+		pos: []string{"\tMOVD\t\\$1311768467463790320, R[0-9]+\n\t0x0010 "},
 	},
 	{
-		// check that we don't emit comparisons for constant shift
 		fn: `
-//go:nosplit
-		func $(x int) int {
-			return x << 17
+		func $() uint32 {
+			return 0x12345678
 		}
 		`,
-		pos: []string{"LSL\t\\$17"},
-		neg: []string{"CMP"},
+		pos: []string{"\tMOVD\t\\$305419896, R[0-9]+\n\t0x0008 "},
 	},
 	{
+		// a bitmask immediate is a single ORR from ZR
 		fn: `
-		func $(a int32, ptr *int) {
-			if a >= 0 {
-				*ptr = 0
-			}
+		func $() uint64 {
+			return 0xffff0000ffff0000
 		}
 		`,
-		pos: []string{"TBNZ"},
+		pos: []string{"\tMOVD\t\\$-281470681808896, R[0-9]+\n\t0x0004 "},
 	},
 	{
 		fn: `
-		func $(a int64, ptr *int) {
-			if a >= 0 {
-				*ptr = 0
-			}
+		func $() uint64 {
+			return 0x5555555555555555
 		}
 		`,
-		pos: []string{"TBNZ"},
+		pos: []string{"\tMOVD\t\\$6148914691236517205, R[0-9]+\n\t0x0004 "},
+		neg: []string{"\tMOVK\t"},
 	},
 	{
+		// not a rotated run of ones: MOVZ and three MOVKs
 		fn: `
-		func $(a int32, ptr *int) {
-			if a < 0 {
-				*ptr = 0
-			}
+		func $() uint64 {
+			return 0x5555555555555554
 		}
 		`,
-		pos: []string{"TBZ"},
+		pos: []string{"\tMOVD\t\\$6148914691236517204, R[0-9]+\n\t0x0010 "},
 	},
+	// A dot product accumulates in order with scalar fused
+	// multiply-adds. Vectorizing it would reassociate the additions
+	// and change the result.
 	{
 		fn: `
-		func $(a int64, ptr *int) {
-			if a < 0 {
-				*ptr = 0
+		func $(a, b []float64) float64 {
+			var sum float64
+			for i := range a {
+				sum += a[i] * b[i]
 			}
+			return sum
 		}
 		`,
-		pos: []string{"TBZ"},
+		pos: []string{"\tFMADDD\t"},
+		neg: []string{"\tVFMLA\t", "\tVLD1\t"},
 	},
-	// Load-combining tests.
+	// Replacing a zero value by a default is a conditional select,
+	// and x is not reloaded for the use that follows.
 	{
 		fn: `
-		func $(s []byte) uint16 {
-			return uint16(s[0]) | uint16(s[1]) << 8
+		func $(x, d int) int {
+			if x == 0 {
+				x = d
+			}
+			return x * 3
 		}
 		`,
-		pos: []string{"\tMOVHU\t\\(R[0-9]+\\)"},
-		neg: []string{"ORR\tR[0-9]+<<8\t"},
+		pos: []string{"\tCSEL\tEQ, "},
+		neg: []string{"\tCBN?Z\t", "\tB(EQ|NE)\t", "(?s)CSEL.*\"\"\\.x\\("},
 	},
+	// A small leaf function needs no frame and no stack check.
 	{
-		// make sure that CSEL is emitted for conditional moves
 		fn: `
-		func f37(c int) int {
-		     x := c + 4
-		     if c < 0 {
-		     	x = 182
-		     }
-		     return x
+		func $(a, b int) int {
+			return a*3 + b
 		}
 		`,
-		pos: []string{"\tCSEL\t"},
+		maxFrameInsts: 1,
 	},
-	// Check that zero stores are combine into larger stores
+	// Clamping an index to the last element of an array is a CSEL,
+	// and prove knows the clamped index is in bounds.
 	{
 		fn: `
-		func $(b []byte) {
-			_ = b[1] // early bounds check to guarantee safety of writes below
-			b[0] = 0
-			b[1] = 0
+		func $(t *[16]int, i uint) int {
+			if i >= uint(len(t)) {
+				i = uint(len(t)) - 1
+			}
+			return t[i]
 		}
 		`,
-		pos: []string{"MOVH\tZR"},
-		neg: []string{"MOVB"},
+		pos:      []string{"\tCMP\t\\$16, ", "\tCSEL\tHS, "},
+		neg:      []string{"\tB(HS|LO|LS|HI)\t"},
+		negCalls: []string{"runtime.panicindex"},
 	},
+	// 32-bit compare-and-swap uses the word-sized acquire/release
+	// exclusive instructions.
 	{
 		fn: `
-		func $(b []byte) {
-			_ = b[1] // early bounds check to guarantee safety of writes below
-			b[1] = 0
-			b[0] = 0
+		func $(p *uint32, old, new uint32) bool {
+			return atomic.CompareAndSwapUint32(p, old, new)
 		}
 		`,
-		pos: []string{"MOVH\tZR"},
-		neg: []string{"MOVB"},
+		imports: []string{"sync/atomic"},
+		pos:     []string{"\tLDAXRW\t", "\tSTLXRW\t"},
+		neg:     []string{"\tLDAXR\t", "\tSTLXR\t", "\tLDXRW?\t", "\tSTXRW?\t"},
 	},
+	// The parallel assignment is a shuffle of the loop's phis, which
+	// needs no more than one move per variable.
 	{
 		fn: `
-		func $(b []byte) {
-			_ = b[3] // early bounds check to guarantee safety of writes below
-			b[0] = 0
-			b[1] = 0
-			b[2] = 0
-			b[3] = 0
+		func $(n, x, y, z int) int {
+			for i := 0; i < n; i++ {
+				x, y, z = y, z, x+1
+			}
+			return x*100 + y*10 + z
 		}
 		`,
-		pos: []string{"MOVW\tZR"},
-		neg: []string{"MOVB", "MOVH"},
+		noCopyChains: true,
 	},
 	{
 		fn: `
-		func $(b []byte) {
-			_ = b[3] // early bounds check to guarantee safety of writes below
-			b[2] = 0
-			b[3] = 0
-			b[1] = 0
-			b[0] = 0
+		func $(n int, x, y, z float64) float64 {
+			for i := 0; i < n; i++ {
+				x, y, z = y, z, x*2
+			}
+			return x + y*z
 		}
 		`,
-		pos: []string{"MOVW\tZR"},
-		neg: []string{"MOVB", "MOVH"},
+		noCopyChains: true,
 	},
+	// The quotient and remainder of the same operands share a
+	// divide; the remainder is computed as x - q*y.
 	{
 		fn: `
-		func $(h []uint16) {
-			_ = h[1] // early bounds check to guarantee safety of writes below
-			h[0] = 0
-			h[1] = 0
+		func $(x, y int) (int, int) {
+			return x / y, x % y
 		}
 		`,
-		pos: []string{"MOVW\tZR"},
-		neg: []string{"MOVB", "MOVH"},
+		counts: map[string]int{"\tSDIV\t": 1, "\tMSUB\t": 1, "\tREM\t": 0},
 	},
 	{
 		fn: `
-		func $(h []uint16) {
-			_ = h[1] // early bounds check to guarantee safety of writes below
-			h[1] = 0
-			h[0] = 0
+		func $(x, y uint32) (uint32, uint32) {
+			return x / y, x % y
 		}
 		`,
-		pos: []string{"MOVW\tZR"},
-		neg: []string{"MOVB", "MOVH"},
+		counts: map[string]int{"\tUDIVW\t": 1, "\tMSUBW\t": 1, "\tUREMW\t": 0},
 	},
+	// A load through a pointer that was already dereferenced cannot
+	// fault, so it is done whatever the condition and its result
+	// chosen with CSEL.
 	{
 		fn: `
-		func $(b []byte) {
-			_ = b[7] // early bounds check to guarantee safety of writes below
-			b[0] = 0
-			b[1] = 0
-			b[2] = 0
-			b[3] = 0
-			b[4] = 0
-			b[5] = 0
-			b[6] = 0
-			b[7] = 0
+		func $(p *[2]int, c bool) int {
+			x := p[0]
+			if c {
+				x = p[1]
+			}
+			return x
 		}
 		`,
-		pos: []string{"MOVD\tZR"},
-		neg: []string{"MOVB", "MOVH", "MOVW"},
+		pos: []string{"\tCSEL\t"},
+		neg: []string{"\tCBN?Z\t", "\tTBN?Z\t", "\tB(EQ|NE)\t"},
 	},
+	// A pointer to a huge array, as made from a C pointer, need not
+	// point to all of it, so p[0] being loaded says nothing of p[1000].
 	{
 		fn: `
-		func $(h []uint16) {
-			_ = h[3] // early bounds check to guarantee safety of writes below
-			h[0] = 0
-			h[1] = 0
-			h[2] = 0
-			h[3] = 0
+		func $(p *[1 << 30]int, n int) int {
+			x := p[0]
+			if n > 1000 {
+				x = p[1000]
+			}
+			return x
 		}
 		`,
-		pos: []string{"MOVD\tZR"},
-		neg: []string{"MOVB", "MOVH", "MOVW"},
+		pos: []string{"\tBLE\t"},
+		neg: []string{"\tCSEL\t"},
 	},
+	// p != nil only makes *p safe on one path, and nothing is known
+	// about p when only c is tested, so these loads keep their branch.
 	{
 		fn: `
-		func $(h []uint16) {
-			_ = h[3] // early bounds check to guarantee safety of writes below
-			h[2] = 0
-			h[3] = 0
-			h[1] = 0
-			h[0] = 0
+		func $(p *int) int {
+			x := 0
+			if p != nil {
+				x = *p
+			}
+			return x
 		}
 		`,
-		pos: []string{"MOVD\tZR"},
-		neg: []string{"MOVB", "MOVH", "MOVW"},
+		pos: []string{"\tCBZ\t"},
+		neg: []string{"\tCSEL\t"},
 	},
 	{
 		fn: `
-		func $(w []uint32) {
-			_ = w[1] // early bounds check to guarantee safety of writes below
-			w[0] = 0
-			w[1] = 0
+		func $(p *int, c bool) int {
+			x := 0
+			if c {
+				x = *p
+			}
+			return x
 		}
 		`,
-		pos: []string{"MOVD\tZR"},
-		neg: []string{"MOVB", "MOVH", "MOVW"},
+		pos: []string{"\tCBZ\t"},
+		neg: []string{"\tCSEL\t"},
 	},
+	// 1-based index arithmetic multiplies and adds in one MADD or
+	// MSUB, leaving just the subtraction of 1.
 	{
 		fn: `
-		func $(w []uint32) {
-			_ = w[1] // early bounds check to guarantee safety of writes below
-			w[1] = 0
-			w[0] = 0
+		func $(base, i, size int) int {
+			return base + (i-1)*size
 		}
 		`,
-		pos: []string{"MOVD\tZR"},
-		neg: []string{"MOVB", "MOVH", "MOVW"},
+		pos:    []string{"\tMADD\t"},
+		counts: map[string]int{"\tMUL\t": 0, "\tADD\t": 0, "\tSUB\t": 1},
 	},
 	{
 		fn: `
-		func $(b []byte) {
-			_ = b[15] // early bounds check to guarantee safety of writes below
-			b[0] = 0
-			b[1] = 0
-			b[2] = 0
-			b[3] = 0
-			b[4] = 0
-			b[5] = 0
-			b[6] = 0
-			b[7] = 0
-			b[8] = 0
-			b[9] = 0
-			b[10] = 0
-			b[11] = 0
-			b[12] = 0
-			b[13] = 0
-			b[15] = 0
-			b[14] = 0
+		func $(base, i, size int32) int32 {
+			return base - (i-1)*size
 		}
 		`,
-		pos: []string{"STP"},
-		neg: []string{"MOVB", "MOVH", "MOVW"},
+		pos:    []string{"\tMSUBW\t"},
+		counts: map[string]int{"\tMULW\t": 0, "\tSUB\t": 1},
 	},
 	{
 		fn: `
-		func $(h []uint16) {
-			_ = h[7] // early bounds check to guarantee safety of writes below
-			h[0] = 0
-			h[1] = 0
-			h[2] = 0
-			h[3] = 0
-			h[4] = 0
-			h[5] = 0
-			h[6] = 0
-			h[7] = 0
+		func $(a []int64, i, j, n int) int64 {
+			return a[(i-1)*n+j]
 		}
 		`,
-		pos: []string{"STP"},
-		neg: []string{"MOVB", "MOVH"},
+		pos:    []string{"\tMADD\t"},
+		counts: map[string]int{"\tMUL\t": 0, "\tSUB\t": 1},
 	},
+	// The canonical SWAR population count is replaced with VCNT,
+	// masks and all.
 	{
 		fn: `
-		func $(w []uint32) {
-			_ = w[3] // early bounds check to guarantee safety of writes below
-			w[0] = 0
-			w[1] = 0
-			w[2] = 0
-			w[3] = 0
+		func $(x uint64) int {
+			x -= (x >> 1) & 0x5555555555555555
+			x = (x & 0x3333333333333333) + ((x >> 2) & 0x3333333333333333)
+			x = (x + (x >> 4)) & 0x0f0f0f0f0f0f0f0f
+			return int((x * 0x0101010101010101) >> 56)
 		}
 		`,
-		pos: []string{"STP"},
-		neg: []string{"MOVB", "MOVH"},
+		pos: []string{"\tVCNT\t", "\tVUADDLV\t"},
+		neg: []string{"\tMUL\t", "\\$6148914691236517205", "\\$3689348814741910323", "\\$1085102592571150095", "\\$72340172838076673"},
 	},
+	// Any other final shift leaves it alone.
 	{
 		fn: `
-		func $(w []uint32) {
-			_ = w[3] // early bounds check to guarantee safety of writes below
-			w[1] = 0
-			w[0] = 0
-			w[3] = 0
-			w[2] = 0
+		func $(x uint64) int {
+			x -= (x >> 1) & 0x5555555555555555
+			x = (x & 0x3333333333333333) + ((x >> 2) & 0x3333333333333333)
+			x = (x + (x >> 4)) & 0x0f0f0f0f0f0f0f0f
+			return int((x * 0x0101010101010101) >> 48)
 		}
 		`,
-		pos: []string{"STP"},
-		neg: []string{"MOVB", "MOVH"},
+		pos: []string{"\tMUL\t"},
+		neg: []string{"VCNT"},
 	},
+	// A fixed-stride gather with a small constant trip count is
+	// fully unrolled, with the offsets folded into the loads.
 	{
 		fn: `
-		func $(d []uint64) {
-			_ = d[1] // early bounds check to guarantee safety of writes below
-			d[0] = 0
-			d[1] = 0
+		func $(dst *[4]int, src []int) {
+			for i := 0; i < 4; i++ {
+				dst[i] = src[i*3]
+			}
 		}
 		`,
-		pos: []string{"STP"},
-		neg: []string{"MOVB", "MOVH"},
+		pos: []string{"\tMOVD\t\\(R[0-9]+\\), R", "\tMOVD\t24\\(R[0-9]+\\), R", "\tMOVD\t48\\(R[0-9]+\\), R", "\tMOVD\t72\\(R[0-9]+\\), R"},
+		neg: []string{"\tB(LT|GE|NE|EQ|LE|GT)\t", "\tJMP\t[1-9]"},
 	},
+	// A saturating increment is a compare and a CSINC, which keeps
+	// x when it is already at the maximum and increments it
+	// otherwise, with no branch.
 	{
 		fn: `
-		func $(d []uint64) {
-			_ = d[1] // early bounds check to guarantee safety of writes below
-			d[1] = 0
-			d[0] = 0
+		func $(x uint32) uint32 {
+			if x != 1<<32-1 {
+				x++
+			}
+			return x
 		}
 		`,
-		pos: []string{"STP"},
-		neg: []string{"MOVB", "MOVH"},
+		pos: []string{"\tCMNW\t\\$1, ", "\tCSINC\tEQ, "},
+		neg: []string{"\tB[A-Z]*\t", "\tADD\t", "\tCSEL\t"},
 	},
 	{
 		fn: `
-		func $(a *[39]byte) {
-			*a = [39]byte{}
+		func $(x, max int) int {
+			if x != max {
+				x++
+			}
+			return x
 		}
 		`,
-		pos: []string{"MOVD"},
-		neg: []string{"MOVB", "MOVH", "MOVW"},
+		pos: []string{"\tCMP\t", "\tCSINC\tEQ, "},
+		neg: []string{"\tB[A-Z]*\t", "\tADD\t", "\tCSEL\t"},
 	},
 	{
 		fn: `
-		func $(a *[30]byte) {
-			*a = [30]byte{}
+		func $(x uint8) uint8 {
+			if x < 255 {
+				x++
+			}
+			return x
 		}
 		`,
-		pos: []string{"STP"},
-		neg: []string{"MOVB", "MOVH", "MOVW"},
+		pos: []string{"\tCMPW\t\\$255, ", "\tCSINC\tHS, "},
+		neg: []string{"\tB[A-Z]*\t", "\tADD\t", "\tCSEL\t"},
 	},
 }
 
@@ -1249,7 +4765,19 @@ var linuxMIPSTests = []*asmTest{
 			return *(&x)
 		}
 		`,
-		pos: []string{"TEXT\t.*, [$]-4-4"},
+		frameSize: -4,
+		argSize:   4,
+	},
+	// Load-combining tests. mips is big-endian, so bytes loaded in
+	// little-endian order must not become a plain halfword load.
+	{
+		fn: `
+		func $(s []byte) uint16 {
+			return uint16(s[0]) | uint16(s[1]) << 8
+		}
+		`,
+		pos: []string{"\tMOVBU\t\\(R[0-9]+\\)", "\tMOVBU\t1\\(R[0-9]+\\)"},
+		neg: []string{"\tMOVHU?\t\\(R[0-9]+\\)"},
 	},
 }
 
@@ -1266,6 +4794,22 @@ var linuxMIPS64Tests = []*asmTest{
 	},
 }
 
+// linuxPPC64Tests are for big-endian ppc64. Tests that do not depend
+// on the byte order belong in linuxPPC64LETests.
+var linuxPPC64Tests = []*asmTest{
+	// Load-combining tests. Bytes loaded in little-endian order must
+	// not become a plain halfword load, only a byte-reversed one.
+	{
+		fn: `
+		func $(s []byte) uint16 {
+			return uint16(s[0]) | uint16(s[1]) << 8
+		}
+		`,
+		pos: []string{"\t(MOVBZ\t1|MOVHBR\t)\\(R[0-9]+\\)"},
+		neg: []string{"\tMOVHZ?\t\\(R[0-9]+\\)"},
+	},
+}
+
 var linuxPPC64LETests = []*asmTest{
 	// Fused multiply-add/sub instructions.
 	{
@@ -1308,7 +4852,72 @@ var linuxPPC64LETests = []*asmTest{
 			return *(&x)
 		}
 		`,
-		pos: []string{"TEXT\t.*, [$]0-8"},
+		frameSize: 0,
+		argSize:   8,
+	},
+	// The canonical SWAR population count is replaced with POPCNTD.
+	{
+		fn: `
+		func $(x uint64) int {
+			x -= (x >> 1) & 0x5555555555555555
+			x = (x & 0x3333333333333333) + ((x >> 2) & 0x3333333333333333)
+			x = (x + (x >> 4)) & 0x0f0f0f0f0f0f0f0f
+			return int((x * 0x0101010101010101) >> 56)
+		}
+		`,
+		pos: []string{"\tPOPCNTD\t"},
+		neg: []string{"\tMULLD\t", "\tSRD\t"},
+	},
+	// Byte reversal. ppc64le requires POWER8, which has byte-reversed
+	// loads and stores but, unlike POWER10 with BRD, no byte reversal
+	// of a register. This tree has no GOPPC64 setting to assume a later
+	// level, so a register is reversed by rotating and inserting the
+	// bytes of each 32-bit word.
+	{
+		fn: `
+		func $(p *uint64) uint64 {
+			return bits.ReverseBytes64(*p)
+		}
+		`,
+		pos: []string{"\tMOVDBR\t\\(R[0-9]+\\), R[0-9]+"},
+		neg: []string{"\tRLWMI\t", "\tCALL\t"},
+	},
+	{
+		fn: `
+		func $(p []uint64, x uint64) {
+			p[1] = bits.ReverseBytes64(x)
+		}
+		`,
+		pos: []string{"\tMOVDBR\tR[0-9]+, \\(R[0-9]+\\)"},
+		neg: []string{"\tRLWMI\t"},
+	},
+	{
+		fn: `
+		func $(p *uint32) uint32 {
+			return bits.ReverseBytes32(*p)
+		}
+		`,
+		pos: []string{"\tMOVWBR\t\\(R[0-9]+\\), R[0-9]+"},
+		neg: []string{"\tRLWMI\t"},
+	},
+	{
+		fn: `
+		func $(x uint64) uint64 {
+			return bits.ReverseBytes64(x) + 1
+		}
+		`,
+		pos:    []string{"\tSRD\t\\$32, ", "\tRLDIMI\t\\$32, "},
+		counts: map[string]int{"\tRLWMI\t": 4, "\tROTLW\t": 2},
+		neg:    []string{"\tCALL\t", "BR\t"},
+	},
+	{
+		fn: `
+		func $(x uint32) uint32 {
+			return bits.ReverseBytes32(x) + 1
+		}
+		`,
+		counts: map[string]int{"\tRLWMI\t": 2, "\tROTLW\t": 1},
+		neg:    []string{"\tCALL\t", "BR\t"},
 	},
 }
 
@@ -1338,6 +4947,119 @@ var plan9AMD64Tests = []*asmTest{
 	},
 }
 
+// linuxAMD64SSATests are compiled with -S=2, to check the SSA values and
+// blocks that instructions come from.
+var linuxAMD64SSATests = []*asmTest{
+	// branchelim folds the if into the entry block: the only block
+	// with code is the one ending in RET, and the CMOVQ is a value.
+	{
+		fn: `
+		func $(c bool, x, y int) int {
+			if c {
+				x = y
+			}
+			return x
+		}
+		`,
+		pos:           []string{"\n b1 +\t\\d+ \\(\\d+\\)\tRET", "\n v\\d+ +\t\\d+ \\(\\d+\\)\tCMOVQNE\t"},
+		neg:           []string{"\n b[2-9]"},
+		maxFrameInsts: 1,
+	},
+	// A bounds check ends its block with a branch to the block of
+	// the panic, which ends with UNDEF.
+	{
+		fn: `
+		func $(a []int, i int) int {
+			return a[i]
+		}
+		`,
+		posOrdered: []string{"\n b1 +\t\\d+ \\(\\d+\\)\tJCC\t", "\n v\\d+ +\t\\d+ \\(\\d+\\)\tCALL\truntime.panicindex", "\n b\\d+ +\t\\d+ \\(\\d+\\)\tUNDEF"},
+	},
+}
+
+// darwinAMD64Tests and darwinARM64Tests mostly check that code
+// generation does not depend on the OS, unlike on Plan 9, past the
+// OS-specific stack check. Function names are listed as on linux:
+// the underscore prefixing Mach-O symbols is added by the linker.
+var darwinAMD64Tests = []*asmTest{
+	// The stack check loads g from thread-local storage. The
+	// compiler leaves the TLS access to the assembler and linker,
+	// which rewrite it for darwin.
+	{
+		fn: `
+		func $(p []int, f func(int) int) int {
+			return f(p[0])
+		}
+		`,
+		pos:             []string{"\tMOVQ\t\\(TLS\\), CX\n.*\tCMPQ\tSP, 16\\(CX\\)"},
+		neg:             []string{"\tMOVQ\tTLS, "},
+		stackCheckFirst: true,
+	},
+	// Array zeroing uses SSE, as on linux.
+	{
+		fn: `
+		func $() [16]byte {
+			var a [16]byte
+			return a
+		}
+		`,
+		pos:       []string{"\tXORPS\tX0, X0", "\tMOVUPS\tX0, \"\""},
+		sameAsmOS: []string{"linux"},
+	},
+	// A loop with a call needs a frame, and branches past the prologue.
+	{
+		fn: `
+		func $(p []int, f func(int) int) int {
+			s := 0
+			for _, x := range p {
+				s += f(x)
+			}
+			return s
+		}
+		`,
+		pos:       []string{"\tCALL\t"},
+		sameAsmOS: []string{"linux"},
+	},
+}
+
+var darwinARM64Tests = []*asmTest{
+	// g is kept in a register, so the stack check reads the stack
+	// guard without touching thread-local storage.
+	{
+		fn: `
+		func $(p []int, f func(int) int) int {
+			return f(p[0])
+		}
+		`,
+		pos:             []string{"\tMOVD\t16\\(g\\), R1\n.*\tMOVD\tRSP, R2\n.*\tCMP\tR1, R2"},
+		neg:             []string{"TLS"},
+		stackCheckFirst: true,
+	},
+	{
+		fn: `
+		func $() [16]byte {
+			var a [16]byte
+			return a
+		}
+		`,
+		pos:       []string{"\tSTP\t\\(ZR, ZR\\)"},
+		sameAsmOS: []string{"linux"},
+	},
+	{
+		fn: `
+		func $(p []int, f func(int) int) int {
+			s := 0
+			for _, x := range p {
+				s += f(x)
+			}
+			return s
+		}
+		`,
+		pos:       []string{"\tCALL\t"},
+		sameAsmOS: []string{"linux"},
+	},
+}
+
 // TestLineNumber checks to make sure the generated assembly has line numbers
 // see issue #16214
 func TestLineNumber(t *testing.T) {
@@ -279,6 +279,7 @@ var (
 	Deferreturn,
 	Duffcopy,
 	Duffzero,
+	OneBitCount,
 	panicindex,
 	panicslice,
 	panicdivide,
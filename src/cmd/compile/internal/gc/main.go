@@ -36,7 +36,7 @@ var (
 
 var (
 	Debug_append       int
-	Debug_asm          bool
+	Debug_asm          int
 	Debug_closure      int
 	Debug_compilelater int
 	debug_dclstack     int
@@ -183,7 +183,7 @@ func Main(archInit func(*Arch)) {
 	objabi.Flagcount("K", "debug missing line numbers", &Debug['K'])
 	objabi.Flagcount("L", "show full file names in error messages", &Debug['L'])
 	objabi.Flagcount("N", "disable optimizations", &Debug['N'])
-	flag.BoolVar(&Debug_asm, "S", false, "print assembly listing")
+	objabi.Flagcount("S", "print assembly listing; -S=2 also prints the SSA value or block of each instruction", &Debug_asm)
 	objabi.AddVersionFlag() // -V
 	objabi.Flagcount("W", "debug parse tree after type checking", &Debug['W'])
 	flag.StringVar(&asmhdr, "asmhdr", "", "write assembly header to `file`")
@@ -246,7 +246,7 @@ func Main(archInit func(*Arch)) {
 	Ctxt.Flag_dynlink = flag_dynlink
 	Ctxt.Flag_optimize = Debug['N'] == 0
 
-	Ctxt.Debugasm = Debug_asm
+	Ctxt.Debugasm = Debug_asm > 0
 	Ctxt.Debugvlog = Debug_vlog
 	if flagDWARF {
 		Ctxt.DebugInfo = debuginfo
@@ -1218,8 +1218,9 @@ func concurrentBackendAllowed() bool {
 	// Debug_asm by itself is ok, because all printing occurs
 	// while writing the object file, and that is non-concurrent.
 	// Adding Debug_vlog, however, causes Debug_asm to also print
-	// while flushing the plist, which happens concurrently.
-	if Debug_vlog || debugstr != "" || debuglive > 0 {
+	// while flushing the plist, which happens concurrently, and so
+	// does -S=2, which prints while generating code.
+	if Debug_vlog || Debug_asm > 1 || debugstr != "" || debuglive > 0 {
 		return false
 	}
 	// TODO: Test and delete these conditions.
@@ -208,6 +208,10 @@ func walkrange(n *Node) *Node {
 			lineno = lno
 			return n
 		}
+		if memsetrange(n, v1, v2, a) {
+			lineno = lno
+			return n
+		}
 
 		// orderstmt arranged for a copy of the array/slice variable if needed.
 		ha := a
@@ -535,3 +539,114 @@ func memclrrange(n, v1, v2, a *Node) bool {
 	n = walkstmt(n)
 	return true
 }
+
+// Lower n into a loop storing 8 bytes at a time, if possible, for
+// fast filling of byte slices and arrays with a constant.
+// Look for instances of
+//
+// for i := range a {
+// 	a[i] = c
+// }
+//
+// in which the elements of a are bytes, c is a constant other than
+// zero, which memclrrange handles, and the evaluation of a is
+// side-effect-free.
+//
+// Parameters are as in walkrange: "for v1, v2 = range a".
+func memsetrange(n, v1, v2, a *Node) bool {
+	if Debug['N'] != 0 || instrumenting {
+		return false
+	}
+	if v1 == nil || v2 != nil {
+		return false
+	}
+	if n.Nbody.Len() == 0 || n.Nbody.First() == nil || n.Nbody.Len() > 1 {
+		return false
+	}
+	stmt := n.Nbody.First() // only stmt in body
+	if stmt.Op != OAS || stmt.Left.Op != OINDEX {
+		return false
+	}
+	if !samesafeexpr(stmt.Left.Left, a) || !samesafeexpr(stmt.Left.Right, v1) {
+		return false
+	}
+	elem := n.Type.Elem()
+	if elem.Width != 1 || !elem.IsInteger() || !Isconst(stmt.Right, CTINT) || iszero(stmt.Right) {
+		return false
+	}
+	c := stmt.Right
+
+	// Convert to
+	// if len(a) != 0 {
+	// 	hn = len(a)
+	// 	hi = 0
+	// 	for ; hi <= hn-8; hi += 8 {
+	// 		hp = (*[8]elem(a))(unsafe.Pointer(&a[hi]))
+	// 		hp[0] = c
+	// 		hp[1] = c
+	// 		...
+	// 		hp[7] = c
+	// 	}
+	// 	for ; hi < hn; hi++ {
+	// 		a[hi] = c
+	// 	}
+	// 	i = len(a) - 1
+	// }
+	//
+	// The indexes are known to be in bounds and hp non-nil. On
+	// architectures that allow unaligned stores, the SSA backend
+	// combines the stores through hp into one 8 byte store.
+	n.Op = OIF
+
+	n.Nbody.Set(nil)
+	n.Left = nod(ONE, nod(OLEN, a, nil), nodintconst(0))
+
+	// hn = len(a)
+	hn := temp(types.Types[TINT])
+	n.Nbody.Append(nod(OAS, hn, nod(OLEN, a, nil)))
+
+	// hi = 0
+	hi := temp(types.Types[TINT])
+	n.Nbody.Append(nod(OAS, hi, nodintconst(0)))
+
+	// for ; hi <= hn-8; hi += 8 { ... }
+	loop := nod(OFOR, nod(OLE, hi, nod(OSUB, hn, nodintconst(8))), nod(OAS, hi, nod(OADD, hi, nodintconst(8))))
+
+	// hp = (*[8]elem(a))(unsafe.Pointer(&a[hi]))
+	hp := temp(types.NewPtr(types.NewArray(elem, 8)))
+	hp.SetNonNil(true)
+
+	tmp := nod(OINDEX, a, hi)
+	tmp.SetBounded(true)
+	tmp = nod(OADDR, tmp, nil)
+	tmp = nod(OCONVNOP, tmp, nil)
+	tmp.Type = types.Types[TUNSAFEPTR]
+	tmp = nod(OCONVNOP, tmp, nil)
+	tmp.Type = hp.Type
+	loop.Nbody.Append(nod(OAS, hp, tmp))
+
+	// hp[off] = c
+	for off := int64(0); off < 8; off++ {
+		tmp = nod(OINDEX, nod(OIND, hp, nil), nodintconst(off))
+		loop.Nbody.Append(nod(OAS, tmp, c))
+	}
+	n.Nbody.Append(loop)
+
+	// for ; hi < hn; hi++ { a[hi] = c }
+	loop = nod(OFOR, nod(OLT, hi, hn), nod(OAS, hi, nod(OADD, hi, nodintconst(1))))
+	tmp = nod(OINDEX, a, hi)
+	tmp.SetBounded(true)
+	loop.Nbody.Append(nod(OAS, tmp, c))
+	n.Nbody.Append(loop)
+
+	// i = len(a) - 1
+	v1 = nod(OAS, v1, nod(OSUB, nod(OLEN, a, nil), nodintconst(1)))
+
+	n.Nbody.Append(v1)
+
+	n.Left = typecheck(n.Left, Erv)
+	n.Left = defaultlit(n.Left, nil)
+	typecheckslice(n.Nbody.Slice(), Etop)
+	n = walkstmt(n)
+	return true
+}
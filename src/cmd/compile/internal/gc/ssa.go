@@ -58,6 +58,7 @@ func initssaconfig() {
 	Deferreturn = sysfunc("deferreturn")
 	Duffcopy = sysfunc("duffcopy")
 	Duffzero = sysfunc("duffzero")
+	OneBitCount = sysfunc("oneBitCount")
 	panicindex = sysfunc("panicindex")
 	panicslice = sysfunc("panicslice")
 	panicdivide = sysfunc("panicdivide")
@@ -2737,6 +2738,12 @@ func init() {
 		},
 		all...)
 
+	addF("runtime", "memequal128",
+		func(s *state, n *Node, args []*ssa.Value) *ssa.Value {
+			return s.newValue3(ssa.OpEqMem128, types.Types[TBOOL], args[0], args[1], s.mem())
+		},
+		sys.AMD64)
+
 	/******** runtime/internal/sys ********/
 	addF("runtime/internal/sys", "Ctz32",
 		func(s *state, n *Node, args []*ssa.Value) *ssa.Value {
@@ -2752,12 +2759,12 @@ func init() {
 		func(s *state, n *Node, args []*ssa.Value) *ssa.Value {
 			return s.newValue1(ssa.OpBswap32, types.Types[TUINT32], args[0])
 		},
-		sys.AMD64, sys.ARM64, sys.ARM, sys.S390X)
+		sys.AMD64, sys.ARM64, sys.ARM, sys.S390X, sys.PPC64)
 	addF("runtime/internal/sys", "Bswap64",
 		func(s *state, n *Node, args []*ssa.Value) *ssa.Value {
 			return s.newValue1(ssa.OpBswap64, types.Types[TUINT64], args[0])
 		},
-		sys.AMD64, sys.ARM64, sys.ARM, sys.S390X)
+		sys.AMD64, sys.ARM64, sys.ARM, sys.S390X, sys.PPC64)
 
 	/******** runtime/internal/atomic ********/
 	addF("runtime/internal/atomic", "Load",
@@ -2911,12 +2918,12 @@ func init() {
 		func(s *state, n *Node, args []*ssa.Value) *ssa.Value {
 			return s.newValue1(ssa.OpAbs, types.Types[TFLOAT64], args[0])
 		},
-		sys.PPC64)
+		sys.ARM64, sys.PPC64)
 	addF("math", "Copysign",
 		func(s *state, n *Node, args []*ssa.Value) *ssa.Value {
 			return s.newValue2(ssa.OpCopysign, types.Types[TFLOAT64], args[0], args[1])
 		},
-		sys.PPC64)
+		sys.AMD64, sys.PPC64)
 
 	makeRoundAMD64 := func(op ssa.Op) func(s *state, n *Node, args []*ssa.Value) *ssa.Value {
 		return func(s *state, n *Node, args []*ssa.Value) *ssa.Value {
@@ -3141,10 +3148,21 @@ func init() {
 			return s.newValue1(ssa.OpPopCount16, types.Types[TINT], args[0])
 		},
 		sys.ARM64)
-	// Note: no OnesCount8, the Go implementation is faster - just a table load.
+	// OnesCount8 is a table load on amd64 too, but as an SSA op the
+	// rewrite rules can see when only its parity is needed.
+	addF("math/bits", "OnesCount8",
+		func(s *state, n *Node, args []*ssa.Value) *ssa.Value {
+			return s.newValue1(ssa.OpPopCount8, types.Types[TINT], args[0])
+		},
+		sys.AMD64)
 	addF("math/bits", "OnesCount",
 		makeOnesCountAMD64(ssa.OpPopCount64, ssa.OpPopCount32),
 		sys.AMD64)
+	addF("math/bits", "Mul64",
+		func(s *state, n *Node, args []*ssa.Value) *ssa.Value {
+			return s.newValue2(ssa.OpMul64uhilo, types.NewTuple(types.Types[TUINT64], types.Types[TUINT64]), args[0], args[1])
+		},
+		sys.AMD64)
 
 	/******** sync/atomic ********/
 
@@ -3212,6 +3230,10 @@ func findIntrinsic(sym *types.Sym) intrinsicBuilder {
 	if sym.Pkg == localpkg {
 		pkg = myimportpath
 	}
+	if sym.Pkg == Runtimepkg {
+		// Runtime calls generated by the compiler, see syslook.
+		pkg = "runtime"
+	}
 	if flag_race && pkg == "sync/atomic" {
 		// The race detector needs to be able to intercept these calls.
 		// We can't intrinsify them.
@@ -4611,11 +4633,19 @@ func genssa(f *ssa.Func, pp *Progs) {
 	var progToValue map[*obj.Prog]*ssa.Value
 	var progToBlock map[*obj.Prog]*ssa.Block
 	var valueToProgAfter []*obj.Prog // The first Prog following computation of a value v; v is visible at this point.
-	var logProgs = e.log
+	// With -S=2, the annotated listing goes with the assembly
+	// listing printed when writing the object file.
+	var logProgs = e.log || Debug_asm > 1
+	logf := f.Logf
+	if !e.log {
+		logf = func(format string, args ...interface{}) {
+			fmt.Fprintf(Ctxt.Bso, format, args...)
+		}
+	}
 	if logProgs {
 		progToValue = make(map[*obj.Prog]*ssa.Value, f.NumValues())
 		progToBlock = make(map[*obj.Prog]*ssa.Block, f.NumBlocks())
-		f.Logf("genssa %s\n", f.Name)
+		logf("genssa %s\n", f.Name)
 		progToBlock[s.pp.next] = f.Blocks[0]
 	}
 
@@ -4739,7 +4769,7 @@ func genssa(f *ssa.Func, pp *Progs) {
 		for p := pp.Text; p != nil; p = p.Link {
 			if p.Pos.IsKnown() && p.InnermostFilename() != filename {
 				filename = p.InnermostFilename()
-				f.Logf("# %s\n", filename)
+				logf("# %s\n", filename)
 			}
 
 			var s string
@@ -4750,7 +4780,7 @@ func genssa(f *ssa.Func, pp *Progs) {
 			} else {
 				s = "   " // most value and branch strings are 2-3 characters long
 			}
-			f.Logf(" %-6s\t%.5d (%s)\t%s\n", s, p.Pc, p.InnermostLineNumber(), p.InstructionString())
+			logf(" %-6s\t%.5d (%s)\t%s\n", s, p.Pc, p.InnermostLineNumber(), p.InstructionString())
 		}
 		if f.HTMLWriter != nil {
 			// LineHist is defunct now - this code won't do
@@ -0,0 +1,119 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gc
+
+import (
+	"cmd/internal/src"
+	"cmd/internal/sys"
+)
+
+// unrollfor fully unrolls n, if possible, for loops with a small
+// constant trip count such as
+//
+// for i := 0; i < 4; i++ {
+// 	dst[i] = src[i*stride]
+// }
+//
+// in which i is a local integer whose address is not taken and the
+// body is a few side-effect-free assignments that do not assign i.
+// init is the statement before n, which must assign i its constant
+// start value. The constant values of i then fold into the addressing
+// of each copy of the body. n is rewritten in place to an OBLOCK.
+//
+// It is only done on arm64, where each copy of the body can use an
+// immediate offset for its loads and stores.
+func unrollfor(init, n *Node) {
+	if Debug['N'] != 0 || instrumenting || thearch.LinkArch.Family != sys.ARM64 {
+		return
+	}
+	if n.Op != OFOR || n.Sym != nil || n.Left == nil || n.Right == nil || n.Ninit.Len() != 0 {
+		return
+	}
+
+	// i = start
+	if init.Op != OAS || init.Ninit.Len() != 0 || init.Right == nil || !Isconst(init.Right, CTINT) {
+		return
+	}
+	i := init.Left
+	if i.Op != ONAME || i.Class() != PAUTO || i.Addrtaken() || !i.Type.IsInteger() {
+		return
+	}
+
+	// i < end
+	cond := n.Left
+	if cond.Op != OLT || cond.Ninit.Len() != 0 || cond.Left != i || !Isconst(cond.Right, CTINT) {
+		return
+	}
+
+	// i++, which order has wrapped in an OBLOCK
+	post := n.Right
+	if post.Op == OBLOCK && post.List.Len() == 1 {
+		post = post.List.First()
+	}
+	if post.Op != OASOP || post.SubOp() != OADD || post.Ninit.Len() != 0 || post.Left != i || !Isconst(post.Right, CTINT) || post.Right.Int64() != 1 {
+		return
+	}
+
+	start, end := init.Right.Int64(), cond.Right.Int64()
+	if end <= start || end-start > 4 {
+		return
+	}
+
+	budget := 16
+	for _, stmt := range n.Nbody.Slice() {
+		if stmt.Op != OAS && stmt.Op != OASOP || stmt.Left == nil || stmt.Left == i {
+			return
+		}
+		if stmt.Ninit.Len() != 0 || !unrollableExpr(stmt.Left, &budget) || !unrollableExpr(stmt.Right, &budget) {
+			return
+		}
+	}
+
+	// Convert to
+	// body
+	// i = start+1
+	// body
+	// ...
+	// i = end
+	var stmts []*Node
+	for v := start; v < end; v++ {
+		if v != start {
+			stmts = append(stmts, nod(OAS, i, nodintconst(v)))
+		}
+		for _, stmt := range n.Nbody.Slice() {
+			stmts = append(stmts, treecopy(stmt, src.NoXPos))
+		}
+	}
+	stmts = append(stmts, nod(OAS, i, nodintconst(end)))
+	typecheckslice(stmts, Etop)
+
+	n.Op = OBLOCK
+	n.Left = nil
+	n.Right = nil
+	n.Nbody.Set(nil)
+	n.List.Set(stmts)
+}
+
+// unrollableExpr reports whether the expression n can be copied by
+// unrollfor. It charges each node against *budget.
+func unrollableExpr(n *Node, budget *int) bool {
+	if n == nil {
+		return true
+	}
+	if *budget--; *budget < 0 {
+		return false
+	}
+	if n.Ninit.Len() != 0 || n.Nbody.Len() != 0 || n.Rlist.Len() != 0 || n.List.Len() != 0 {
+		return false
+	}
+	switch n.Op {
+	case ONAME, OLITERAL:
+		return true
+	case OINDEX, ODOT, ODOTPTR, OIND, OLEN, OCAP, OCONV, OCONVNOP,
+		OADD, OSUB, OMUL, OLSH, ORSH, OAND, OOR, OXOR, OANDNOT, OMINUS, OCOM:
+		return unrollableExpr(n.Left, budget) && unrollableExpr(n.Right, budget)
+	}
+	return false
+}
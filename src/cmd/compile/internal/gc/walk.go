@@ -75,6 +75,11 @@ func walk(fn *Node) {
 
 func walkstmtlist(s []*Node) {
 	for i := range s {
+		if i+1 < len(s) && s[i+1].Op == OFOR {
+			// order has moved the loop's initialization out of
+			// its Ninit, so it is the statement before the loop.
+			unrollfor(s[i], s[i+1])
+		}
 		s[i] = walkstmt(s[i])
 	}
 }
@@ -3299,6 +3304,30 @@ func walkcompare(n *Node, init *Nodes) *Node {
 		cmpr = cmpr.Left
 	}
 
+	// On amd64, compare 16 and 32 bytes of memory with SSE
+	// instead of with integer compares. Only types without
+	// padding or floats can be compared as raw memory, and
+	// only values already in memory are worth it: locals and
+	// literals would have to be spilled to take their address.
+	inmem := func(n *Node) bool {
+		n = outervalue(n)
+		switch n.Op {
+		case ONAME:
+			return n.Class() == PEXTERN
+		case OSTRUCTLIT, OARRAYLIT:
+			return false
+		}
+		return true
+	}
+	vec := false
+	if thearch.LinkArch.Family == sys.AMD64 && !instrumenting && (t.Width == 16 || t.Width == 32) && inmem(cmpl) && inmem(cmpr) {
+		a, _ := algtype1(t)
+		vec = a == AMEM
+	}
+	if vec {
+		inline = false
+	}
+
 	// Chose not to inline. Call equality function directly.
 	if !inline {
 		if isvaluelit(cmpl) {
@@ -3326,14 +3355,19 @@ func walkcompare(n *Node, init *Nodes) *Node {
 		ar = typecheck(ar, Etop)
 		init.Append(ar)
 
-		fn, needsize := eqfor(t)
-		call := nod(OCALL, fn, nil)
-		call.List.Append(pl)
-		call.List.Append(pr)
-		if needsize {
-			call.List.Append(nodintconst(t.Width))
+		var res *Node
+		if vec {
+			res = eqvec(pl, pr, t.Width)
+		} else {
+			fn, needsize := eqfor(t)
+			call := nod(OCALL, fn, nil)
+			call.List.Append(pl)
+			call.List.Append(pr)
+			if needsize {
+				call.List.Append(nodintconst(t.Width))
+			}
+			res = call
 		}
-		res := call
 		if n.Op != OEQ {
 			res = nod(ONOT, res, nil)
 		}
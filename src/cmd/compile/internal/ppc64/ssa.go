@@ -129,6 +129,29 @@ func ssaGenISEL(s *gc.SSAGenState, v *ssa.Value, cr int64, r1, r2 int16) {
 	p.From.Offset = cr
 }
 
+// bswap32 sets register r to the low word of register x with its bytes
+// reversed, zero extended. x and r must differ. Rotating the word
+// left by 8 puts bytes 1 and 3, counting from the most significant
+// one, in place; bytes 0 and 2 are then inserted from the word rotated
+// left by 24.
+func bswap32(s *gc.SSAGenState, x, r int16) {
+	p := s.Prog(ppc64.AROTLW)
+	p.From.Type = obj.TYPE_CONST
+	p.From.Offset = 8
+	p.Reg = x
+	p.To.Type = obj.TYPE_REG
+	p.To.Reg = r
+	for _, mask := range []int64{0xff000000, 0x0000ff00} {
+		p := s.Prog(ppc64.ARLWMI)
+		p.From.Type = obj.TYPE_CONST
+		p.From.Offset = 24
+		p.Reg = x
+		p.SetFrom3(obj.Addr{Type: obj.TYPE_CONST, Offset: mask})
+		p.To.Type = obj.TYPE_REG
+		p.To.Reg = r
+	}
+}
+
 func ssaGenValue(s *gc.SSAGenState, v *ssa.Value) {
 	switch v.Op {
 	case ssa.OpCopy, ssa.OpPPC64MOVDconvert:
@@ -589,6 +612,31 @@ func ssaGenValue(s *gc.SSAGenState, v *ssa.Value) {
 		p.From.Type = obj.TYPE_REG
 		p.From.Reg = v.Args[0].Reg()
 
+	case ssa.OpPPC64BSWAP32:
+		bswap32(s, v.Args[0].Reg(), v.Reg())
+
+	case ssa.OpPPC64BSWAP64:
+		// The high word of x, reversed, makes the low word of the
+		// result. Then the low word of x, reversed in REGTMP, is
+		// rotated into the high word of the result.
+		r := v.Reg()
+		x := v.Args[0].Reg()
+		p := s.Prog(ppc64.ASRD)
+		p.From.Type = obj.TYPE_CONST
+		p.From.Offset = 32
+		p.Reg = x
+		p.To.Type = obj.TYPE_REG
+		p.To.Reg = ppc64.REGTMP
+		bswap32(s, ppc64.REGTMP, r)
+		bswap32(s, x, ppc64.REGTMP)
+		p = s.Prog(ppc64.ARLDIMI)
+		p.From.Type = obj.TYPE_CONST
+		p.From.Offset = 32
+		p.Reg = ppc64.REGTMP
+		p.SetFrom3(obj.Addr{Type: obj.TYPE_CONST, Offset: 0})
+		p.To.Type = obj.TYPE_REG
+		p.To.Reg = r
+
 	case ssa.OpPPC64ADDconst, ssa.OpPPC64ANDconst, ssa.OpPPC64ORconst, ssa.OpPPC64XORconst,
 		ssa.OpPPC64SRADconst, ssa.OpPPC64SRAWconst, ssa.OpPPC64SRDconst, ssa.OpPPC64SRWconst, ssa.OpPPC64SLDconst, ssa.OpPPC64SLWconst:
 		p := s.Prog(v.Op.Asm())
@@ -705,6 +753,20 @@ func ssaGenValue(s *gc.SSAGenState, v *ssa.Value) {
 		p.To.Reg = v.Args[0].Reg()
 		gc.AddAux(&p.To, v)
 
+	case ssa.OpPPC64MOVDBRload, ssa.OpPPC64MOVWBRload:
+		p := s.Prog(v.Op.Asm())
+		p.From.Type = obj.TYPE_MEM
+		p.From.Reg = v.Args[0].Reg()
+		p.To.Type = obj.TYPE_REG
+		p.To.Reg = v.Reg()
+
+	case ssa.OpPPC64MOVDBRstore, ssa.OpPPC64MOVWBRstore:
+		p := s.Prog(v.Op.Asm())
+		p.From.Type = obj.TYPE_REG
+		p.From.Reg = v.Args[1].Reg()
+		p.To.Type = obj.TYPE_MEM
+		p.To.Reg = v.Args[0].Reg()
+
 	case ssa.OpPPC64MOVDstore, ssa.OpPPC64MOVWstore, ssa.OpPPC64MOVHstore, ssa.OpPPC64MOVBstore:
 		p := s.Prog(v.Op.Asm())
 		p.From.Type = obj.TYPE_REG
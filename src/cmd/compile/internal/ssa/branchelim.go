@@ -17,6 +17,9 @@ package ssa
 //
 // where the intermediate blocks are mostly empty (with no side-effects);
 // rewrite Phis in the postdominator as CondSelects.
+//
+// The intermediate blocks may load from memory only where the load
+// cannot fault: see canSpeculativelyLoad.
 func branchelim(f *Func) {
 	// FIXME: add support for lowering CondSelects on more architectures
 	switch f.Config.arch {
@@ -35,7 +38,7 @@ func branchelim(f *Func) {
 	}
 }
 
-func canCondSelect(v *Value, arch string) bool {
+func canCondSelect(v *Value, arch string, cond *Value) bool {
 	// For now, stick to simple scalars that fit in registers
 	switch {
 	case v.Type.Size() > v.Block.Func.Config.RegSize:
@@ -48,11 +51,38 @@ func canCondSelect(v *Value, arch string) bool {
 			return false
 		}
 		return true
+	case v.Type.IsFloat():
+		if arch != "amd64" {
+			return false
+		}
+		// amd64 can select between two float constants by selecting
+		// between their bits in general purpose registers.
+		if isFloatConst(v.Args[0]) && isFloatConst(v.Args[1]) {
+			return true
+		}
+		// amd64 can select the smaller or larger of two floats
+		// with MINSx/MAXSx, so allow float Phis choosing between
+		// the operands of a < or > comparison of the same type.
+		switch cond.Op {
+		case OpLess64F, OpGreater64F, OpLess32F, OpGreater32F:
+		default:
+			return false
+		}
+		x, y := cond.Args[0], cond.Args[1]
+		if x.Type.Size() != v.Type.Size() {
+			return false
+		}
+		a0, a1 := v.Args[0], v.Args[1]
+		return a0 == x && a1 == y || a0 == y && a1 == x
 	default:
 		return false
 	}
 }
 
+func isFloatConst(v *Value) bool {
+	return v.Op == OpConst64F || v.Op == OpConst32F
+}
+
 func elimIf(f *Func, dom *Block) bool {
 	// See if dom is an If with one arm that
 	// is trivial and succeeded by the other
@@ -83,7 +113,7 @@ func elimIf(f *Func, dom *Block) bool {
 	for _, v := range post.Values {
 		if v.Op == OpPhi {
 			hasphis = true
-			if !canCondSelect(v, f.Config.arch) {
+			if !canCondSelect(v, f.Config.arch, dom.Control) {
 				return false
 			}
 		}
@@ -98,7 +128,7 @@ func elimIf(f *Func, dom *Block) bool {
 	// the number of useless instructions executed.
 	const maxfuseinsts = 2
 
-	if len(simple.Values) > maxfuseinsts || !allTrivial(simple) {
+	if len(simple.Values) > maxfuseinsts || !allTrivial(simple, dom) {
 		return false
 	}
 
@@ -166,10 +196,10 @@ func elimIfElse(f *Func, b *Block) bool {
 		return false
 	}
 	yes, no := b.Succs[0].Block(), b.Succs[1].Block()
-	if !isLeafPlain(yes) || len(yes.Values) > 1 || !allTrivial(yes) {
+	if !isLeafPlain(yes) || len(yes.Values) > 1 || !allTrivial(yes, b) {
 		return false
 	}
-	if !isLeafPlain(no) || len(no.Values) > 1 || !allTrivial(no) {
+	if !isLeafPlain(no) || len(no.Values) > 1 || !allTrivial(no, b) {
 		return false
 	}
 	if b.Succs[0].Block().Succs[0].Block() != b.Succs[1].Block().Succs[0].Block() {
@@ -184,7 +214,7 @@ func elimIfElse(f *Func, b *Block) bool {
 	for _, v := range post.Values {
 		if v.Op == OpPhi {
 			hasphis = true
-			if !canCondSelect(v, f.Config.arch) {
+			if !canCondSelect(v, f.Config.arch, b.Control) {
 				return false
 			}
 		}
@@ -238,10 +268,16 @@ func elimIfElse(f *Func, b *Block) bool {
 	return true
 }
 
-func allTrivial(b *Block) bool {
+// allTrivial reports whether the values of b, which is to be fused
+// into dom, can be executed whichever way dom branches.
+func allTrivial(b, dom *Block) bool {
 	// don't fuse memory ops, Phi ops, divides (can panic),
-	// or anything else with side-effects
+	// or anything else with side-effects, except for loads
+	// that cannot fault
 	for _, v := range b.Values {
+		if v.Op == OpLoad && canSpeculativelyLoad(v, dom) {
+			continue
+		}
 		if v.Op == OpPhi || isDivMod(v.Op) || v.Type.IsMemory() ||
 			v.MemoryArg() != nil || opcodeTable[v.Op].hasSideEffects {
 			return false
@@ -250,6 +286,40 @@ func allTrivial(b *Block) bool {
 	return true
 }
 
+// maxSpeculativeLoadElem is the largest pointee type that
+// canSpeculativelyLoad trusts a pointer to point to all of.
+// Pointers to huge arrays, such as *[1 << 30]T made from C pointers,
+// usually point to much less memory than their type says.
+const maxSpeculativeLoadElem = 64
+
+// canSpeculativelyLoad reports whether the load v can be executed on
+// paths that did not execute it before, because it cannot fault: it
+// reads within the small object that a pointer p points to, and p is
+// nil checked in dom or in a block that dominates dom. A condition such
+// as p != nil only proves p non-nil on one path, so it is not enough.
+func canSpeculativelyLoad(v *Value, dom *Block) bool {
+	ptr, off := v.Args[0], int64(0)
+	if ptr.Op == OpOffPtr {
+		ptr, off = ptr.Args[0], ptr.AuxInt
+	}
+	if !ptr.Type.IsPtr() || ptr.Type.Elem().Size() > maxSpeculativeLoadElem {
+		return false
+	}
+	if off < 0 || off+v.Type.Size() > ptr.Type.Elem().Size() {
+		return false
+	}
+	f := dom.Func
+	idom := f.Idom()
+	for b := dom; b != nil; b = idom[b.ID] {
+		for _, w := range b.Values {
+			if w.Op == OpNilCheck && w.Args[0] == ptr {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func isDivMod(op Op) bool {
 	switch op {
 	case OpDiv8, OpDiv8u, OpDiv16, OpDiv16u,
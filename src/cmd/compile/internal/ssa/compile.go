@@ -343,9 +343,11 @@ var passes = [...]pass{
 	{name: "nilcheckelim", fn: nilcheckelim},
 	{name: "prove", fn: prove},
 	{name: "loopbce", fn: loopbce},
+	{name: "loop strength reduce", fn: loopStrengthReduce},
 	{name: "decompose builtin", fn: decomposeBuiltIn, required: true},
 	{name: "softfloat", fn: softfloat, required: true},
 	{name: "late opt", fn: opt, required: true}, // TODO: split required rules and optimizing rules
+	{name: "swar popcount", fn: swarPopCount},
 	{name: "generic deadcode", fn: deadcode},
 	{name: "check bce", fn: checkbce},
 	{name: "branchelim", fn: branchelim},
@@ -411,10 +413,16 @@ var passOrder = [...]constraint{
 	{"generic cse", "tighten"},
 	// checkbce needs the values removed
 	{"generic deadcode", "check bce"},
+	// late opt folds the multiplications loop strength reduce puts before loops
+	{"loop strength reduce", "late opt"},
 	// don't run optimization pass until we've decomposed builtin objects
 	{"decompose builtin", "late opt"},
 	// decompose builtin is the last pass that may introduce new float ops, so run softfloat after it
 	{"decompose builtin", "softfloat"},
+	// swar popcount matches constant shift counts and masks as folded by opt
+	{"late opt", "swar popcount"},
+	// and leaves the operations it replaced for deadcode to remove
+	{"swar popcount", "generic deadcode"},
 	// don't layout blocks until critical edges have been removed
 	{"critical", "layout"},
 	// regalloc requires the removal of all critical edges
@@ -39,6 +39,7 @@ type Config struct {
 	SoftFloat       bool          //
 	NeedsFpScratch  bool          // No direct move between GP and FP register sets
 	BigEndian       bool          //
+	hasPopCount     bool          // PopCount ops need no CPU feature check
 	sparsePhiCutoff uint64        // Sparse phi location algorithm used above this #blocks*#variables score
 }
 
@@ -248,6 +249,7 @@ func NewConfig(arch string, types Types, ctxt *obj.Link, optimize bool) *Config
 		c.LinkReg = linkRegARM64
 		c.hasGReg = true
 		c.noDuffDevice = objabi.GOOS == "darwin" // darwin linker cannot handle BR26 reloc with non-zero addend
+		c.hasPopCount = true
 	case "ppc64":
 		c.BigEndian = true
 		fallthrough
@@ -263,6 +265,7 @@ func NewConfig(arch string, types Types, ctxt *obj.Link, optimize bool) *Config
 		c.LinkReg = linkRegPPC64
 		c.noDuffDevice = true // TODO: Resolve PPC64 DuffDevice (has zero, but not copy)
 		c.hasGReg = true
+		c.hasPopCount = true
 	case "mips64":
 		c.BigEndian = true
 		fallthrough
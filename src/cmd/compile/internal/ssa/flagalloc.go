@@ -27,8 +27,12 @@ func flagalloc(f *Func) {
 				if v == flag {
 					flag = nil
 				}
-				if v.clobbersFlags() {
-					flag = nil
+				if flag != nil && v.clobbersFlags() {
+					// Where possible, use an op that leaves flag
+					// intact rather than recomputing flag after v.
+					if !v.convertToFlagPreserving() {
+						flag = nil
+					}
 				}
 				for _, a := range v.Args {
 					if a.Type.IsFlags() {
@@ -258,3 +262,17 @@ func copyFlags(v *Value, b *Block) *Value {
 	}
 	return c
 }
+
+// convertToFlagPreserving rewrites v, if possible, into an equivalent
+// op that does not clobber flags. It reports whether it did so.
+func (v *Value) convertToFlagPreserving() bool {
+	switch v.Op {
+	case OpAMD64ADDQconst:
+		v.Op = OpAMD64LEAQ
+	case OpAMD64ADDLconst:
+		v.Op = OpAMD64LEAL
+	default:
+		return false
+	}
+	return true
+}
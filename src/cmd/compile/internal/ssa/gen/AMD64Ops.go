@@ -166,6 +166,10 @@ func init() {
 		{name: "MULSD", argLength: 2, reg: fp21, asm: "MULSD", commutative: true, resultInArg0: true}, // fp64 mul
 		{name: "DIVSS", argLength: 2, reg: fp21, asm: "DIVSS", resultInArg0: true},                    // fp32 div
 		{name: "DIVSD", argLength: 2, reg: fp21, asm: "DIVSD", resultInArg0: true},                    // fp64 div
+		{name: "MINSS", argLength: 2, reg: fp21, asm: "MINSS", resultInArg0: true},                    // fp32 arg0 < arg1 ? arg0 : arg1
+		{name: "MINSD", argLength: 2, reg: fp21, asm: "MINSD", resultInArg0: true},                    // fp64 arg0 < arg1 ? arg0 : arg1
+		{name: "MAXSS", argLength: 2, reg: fp21, asm: "MAXSS", resultInArg0: true},                    // fp32 arg0 > arg1 ? arg0 : arg1
+		{name: "MAXSD", argLength: 2, reg: fp21, asm: "MAXSD", resultInArg0: true},                    // fp64 arg0 > arg1 ? arg0 : arg1
 
 		{name: "MOVSSload", argLength: 2, reg: fpload, asm: "MOVSS", aux: "SymOff", faultOnNilArg0: true, symEffect: "Read"}, // fp32 load
 		{name: "MOVSDload", argLength: 2, reg: fpload, asm: "MOVSD", aux: "SymOff", faultOnNilArg0: true, symEffect: "Read"}, // fp64 load
@@ -401,6 +405,9 @@ func init() {
 		{name: "POPCNTQ", argLength: 1, reg: gp11, asm: "POPCNTQ", clobberFlags: true}, // count number of set bits in arg0
 		{name: "POPCNTL", argLength: 1, reg: gp11, asm: "POPCNTL", clobberFlags: true}, // count number of set bits in arg0
 
+		// LoweredPopCount8 counts the set bits in arg0 < 256 by loading from runtime.oneBitCount.
+		{name: "LoweredPopCount8", argLength: 1, reg: gp11, resultNotInArgs: true},
+
 		{name: "SQRTSD", argLength: 1, reg: fp11, asm: "SQRTSD"}, // sqrt(arg0)
 
 		// ROUNDSD instruction isn't guaranteed to be on the target platform (it is SSE4.1)
@@ -471,7 +478,12 @@ func init() {
 		{name: "MOVLi2f", argLength: 1, reg: gpfp, typ: "Float32"}, // move 32 bits from int to float reg
 		{name: "MOVLf2i", argLength: 1, reg: fpgp, typ: "UInt32"},  // move 32 bits from float to int reg
 
-		{name: "PXOR", argLength: 2, reg: fp21, asm: "PXOR", commutative: true, resultInArg0: true}, // exclusive or, applied to X regs for float negation.
+		{name: "PXOR", argLength: 2, reg: fp21, asm: "PXOR", commutative: true, resultInArg0: true},   // exclusive or, applied to X regs for float negation.
+		{name: "ANDPD", argLength: 2, reg: fp21, asm: "ANDPD", commutative: true, resultInArg0: true}, // and, applied to X regs to extract float sign bits.
+		{name: "ORPD", argLength: 2, reg: fp21, asm: "ORPD", commutative: true, resultInArg0: true},   // or, applied to X regs to set float sign bits.
+
+		{name: "PCMPEQB", argLength: 2, reg: fp21, asm: "PCMPEQB", commutative: true, resultInArg0: true, typ: "Int128"}, // bytewise arg0 == arg1, 0xff where equal and 0 elsewhere
+		{name: "PMOVMSKB", argLength: 1, reg: fpgp, asm: "PMOVMSKB", typ: "UInt32"},                                      // mask of the high bits of the 16 bytes of arg0
 
 		{name: "LEAQ", argLength: 1, reg: gp11sb, asm: "LEAQ", aux: "SymOff", rematerializeable: true, symEffect: "Addr"}, // arg0 + auxint + offset encoded in aux
 		{name: "LEAQ1", argLength: 2, reg: gp21sb, commutative: true, aux: "SymOff", symEffect: "Addr"},                   // arg0 + arg1 + auxint + aux
@@ -209,6 +209,12 @@ func init() {
 		{name: "POPCNTW", argLength: 1, reg: gp11, asm: "POPCNTW"}, // number of set bits in each word of arg0 placed in corresponding word
 		{name: "POPCNTB", argLength: 1, reg: gp11, asm: "POPCNTB"}, // number of set bits in each byte of arg0 placed in corresonding byte
 
+		// POWER8 has no instruction reversing the bytes of a register,
+		// so these rotate and insert the bytes of each 32-bit word.
+		// BSWAP64 also uses REGTMP.
+		{name: "BSWAP32", argLength: 1, reg: gp11, resultNotInArgs: true}, // uint32(arg0) with its bytes reversed, zero extended
+		{name: "BSWAP64", argLength: 1, reg: gp11, resultNotInArgs: true}, // arg0 with its bytes reversed
+
 		{name: "FDIV", argLength: 2, reg: fp21, asm: "FDIV"},   // arg0/arg1
 		{name: "FDIVS", argLength: 2, reg: fp21, asm: "FDIVS"}, // arg0/arg1
 
@@ -280,6 +286,13 @@ func init() {
 		{name: "FMOVDstore", argLength: 3, reg: fpstore, asm: "FMOVD", aux: "SymOff", typ: "Mem", faultOnNilArg0: true, symEffect: "Write"},
 		{name: "FMOVSstore", argLength: 3, reg: fpstore, asm: "FMOVS", aux: "SymOff", typ: "Mem", faultOnNilArg0: true, symEffect: "Write"},
 
+		// Byte-reversed loads and stores. They have no offset: the
+		// address is arg0 alone.
+		{name: "MOVDBRload", argLength: 2, reg: gpload, asm: "MOVDBR", typ: "Int64", faultOnNilArg0: true},  // load 8 bytes from arg0, byte-reversed. arg1=mem
+		{name: "MOVWBRload", argLength: 2, reg: gpload, asm: "MOVWBR", typ: "UInt32", faultOnNilArg0: true}, // load 4 bytes from arg0, byte-reversed and zero extended. arg1=mem
+		{name: "MOVDBRstore", argLength: 3, reg: gpstore, asm: "MOVDBR", typ: "Mem", faultOnNilArg0: true},  // store 8 bytes of arg1 to arg0, byte-reversed. arg2=mem
+		{name: "MOVWBRstore", argLength: 3, reg: gpstore, asm: "MOVWBR", typ: "Mem", faultOnNilArg0: true},  // store 4 bytes of arg1 to arg0, byte-reversed. arg2=mem
+
 		{name: "MOVBstorezero", argLength: 2, reg: gpstorezero, asm: "MOVB", aux: "SymOff", typ: "Mem", faultOnNilArg0: true, symEffect: "Write"}, // store zero byte to arg0+aux.  arg1=mem
 		{name: "MOVHstorezero", argLength: 2, reg: gpstorezero, asm: "MOVH", aux: "SymOff", typ: "Mem", faultOnNilArg0: true, symEffect: "Write"}, // store zero 2 bytes to ...
 		{name: "MOVWstorezero", argLength: 2, reg: gpstorezero, asm: "MOVW", aux: "SymOff", typ: "Mem", faultOnNilArg0: true, symEffect: "Write"}, // store zero 4 bytes to ...
@@ -162,6 +162,7 @@ var genericOps = []opData{
 	{name: "EqSlice", argLength: 2, typ: "Bool"}, // arg0 or arg1 is nil; other cases handled by frontend
 	{name: "Eq32F", argLength: 2, commutative: true, typ: "Bool"},
 	{name: "Eq64F", argLength: 2, commutative: true, typ: "Bool"},
+	{name: "EqMem128", argLength: 3, typ: "Bool"}, // the 16 bytes at arg0 and arg1 are equal. arg2=memory
 
 	{name: "Neq8", argLength: 2, commutative: true, typ: "Bool"}, // arg0 != arg1
 	{name: "Neq16", argLength: 2, commutative: true, typ: "Bool"},
@@ -247,6 +248,9 @@ var genericOps = []opData{
 	{name: "BitLen32", argLength: 1}, // Number of bits in arg[0] (returns 0-32)
 	{name: "BitLen64", argLength: 1}, // Number of bits in arg[0] (returns 0-64)
 
+	{name: "Ctz32NonZero", argLength: 1}, // same as Ctz32, but arg[0] known to be non-zero, returns 0-31
+	{name: "Ctz64NonZero", argLength: 1}, // same as Ctz64, but arg[0] known to be non-zero, returns 0-63
+
 	{name: "Bswap32", argLength: 1}, // Swap bytes
 	{name: "Bswap64", argLength: 1}, // Swap bytes
 
@@ -292,6 +292,11 @@ func genRules(arch arch) {
 			if s[0] != "nil" {
 				fmt.Fprintf(w, "v := b.Control\n")
 				if strings.Contains(s[0], "(") {
+					if colon := strings.Index(s[0], ":"); colon >= 0 && colon < strings.Index(s[0], "(") {
+						// rule-specified name for the control value
+						fmt.Fprintf(w, "%s := b.Control\n", s[0][:colon])
+						s[0] = s[0][colon+1:]
+					}
 					genMatch0(w, arch, s[0], "v", map[string]struct{}{}, false, rule.loc)
 				} else {
 					fmt.Fprintf(w, "_ = v\n") // in case we don't use v
@@ -0,0 +1,102 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ssa
+
+// loopStrengthReduce replaces multiplications of an induction variable
+// by a loop invariant with a second induction variable.
+//
+// Look for
+//
+// loop:
+//   ind = (Phi min nxt)
+//   ...
+//   m = (Mul64 ind s)
+//   ...
+//   nxt = (Add64 ind inc)
+//
+// where s is defined outside the loop, and rewrite it to
+//
+// loop:
+//   ind = (Phi min nxt)
+//   acc = (Phi (Mul64 min s) accnxt)
+//   ...
+//   m = (Copy acc)
+//   ...
+//   nxt = (Add64 ind inc)
+//   accnxt = (Add64 acc (Mul64 inc s))
+//
+// with the multiplications by min and inc computed before the loop.
+// Since multiplication distributes over addition modulo 2^64, acc wraps
+// around exactly when ind*s does.
+func loopStrengthReduce(f *Func) {
+	ivList := findIndVar(f)
+	if len(ivList) == 0 {
+		return
+	}
+
+	m := make(map[*Value]indVar)
+	for _, iv := range ivList {
+		m[iv.ind] = iv
+	}
+
+	sdom := f.sdom()
+	// acc for each induction variable and loop invariant,
+	// shared by all the multiplications of the two.
+	acc := make(map[[2]*Value]*Value)
+	for _, b := range f.Blocks {
+		for _, v := range b.Values {
+			if v.Op != OpMul64 {
+				continue
+			}
+			ind, s := v.Args[0], v.Args[1]
+			iv, ok := m[ind]
+			if !ok {
+				ind, s = s, ind
+				iv, ok = m[ind]
+			}
+			// Multiplications by constants are cheap, and
+			// often folded into addressing modes.
+			if !ok || s.Op == OpConst64 || !sdom.isAncestor(s.Block, ind.Block) {
+				continue
+			}
+			a := acc[[2]*Value{ind, s}]
+			if a == nil {
+				a = reduceIndVar(iv, s, v)
+				acc[[2]*Value{ind, s}] = a
+			}
+			if f.pass.debug > 0 {
+				f.Warnl(v.Pos, "Strength reduced %s", v.Op)
+			}
+			v.reset(OpCopy)
+			v.AddArg(a)
+		}
+	}
+}
+
+// reduceIndVar returns a new induction variable that is always iv.ind*s,
+// of the same type as the multiplication v.
+func reduceIndVar(iv indVar, s, v *Value) *Value {
+	header := iv.ind.Block
+	// The predecessor of the header outside the loop.
+	var pre *Block
+	for i, a := range iv.ind.Args {
+		if a != iv.nxt {
+			pre = header.Preds[i].b
+		}
+	}
+
+	init := pre.NewValue2(v.Pos, OpMul64, v.Type, iv.min, s)
+	step := pre.NewValue2(v.Pos, OpMul64, v.Type, iv.inc, s)
+	acc := header.NewValue0(v.Pos, OpPhi, v.Type)
+	next := iv.nxt.Block.NewValue2(v.Pos, OpAdd64, v.Type, acc, step)
+	for _, a := range iv.ind.Args {
+		if a == iv.nxt {
+			acc.AddArg(next)
+		} else {
+			acc.AddArg(init)
+		}
+	}
+	return acc
+}
@@ -0,0 +1,103 @@
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ssa
+
+// swarPopCount replaces the canonical SWAR population count of a
+// 64-bit value x,
+//
+//	x -= (x >> 1) & 0x5555555555555555
+//	x = (x & 0x3333333333333333) + ((x >> 2) & 0x3333333333333333)
+//	x = (x + (x >> 4)) & 0x0f0f0f0f0f0f0f0f
+//	return (x * 0x0101010101010101) >> 56
+//
+// with a PopCount64 of x, on architectures where PopCount64 does not
+// need a CPU feature check. Only this exact sequence of operations
+// and constants is recognized.
+func swarPopCount(f *Func) {
+	if !f.Config.hasPopCount {
+		return
+	}
+	for _, b := range f.Blocks {
+		for _, v := range b.Values {
+			x := swarPopCount64(v)
+			if x == nil {
+				continue
+			}
+			if f.pass.debug > 0 {
+				f.Warnl(v.Pos, "SWAR popcount")
+			}
+			v.reset(OpPopCount64)
+			v.AddArg(x)
+		}
+	}
+}
+
+// swarPopCount64 returns x if v is the result of the SWAR population
+// count of x, and nil otherwise.
+func swarPopCount64(v *Value) *Value {
+	// (y * 0x0101010101010101) >> 56
+	if v.Op != OpRsh64Ux64 || !isRsh64UxConst(v, v.Args[0], 56) {
+		return nil
+	}
+	y := constOperand(v.Args[0], OpMul64, 0x0101010101010101)
+	if y == nil {
+		return nil
+	}
+	// y = (z + (z >> 4)) & 0x0f0f0f0f0f0f0f0f
+	s := constOperand(y, OpAnd64, 0x0f0f0f0f0f0f0f0f)
+	if s == nil || s.Op != OpAdd64 {
+		return nil
+	}
+	z := s.Args[0]
+	if !isRsh64UxConst(s.Args[1], z, 4) {
+		z = s.Args[1]
+		if !isRsh64UxConst(s.Args[0], z, 4) {
+			return nil
+		}
+	}
+	// z = (w & 0x3333333333333333) + ((w >> 2) & 0x3333333333333333)
+	if z.Op != OpAdd64 {
+		return nil
+	}
+	w := constOperand(z.Args[0], OpAnd64, 0x3333333333333333)
+	w2 := constOperand(z.Args[1], OpAnd64, 0x3333333333333333)
+	if w == nil || w2 == nil {
+		return nil
+	}
+	if !isRsh64UxConst(w2, w, 2) {
+		w, w2 = w2, w
+		if !isRsh64UxConst(w2, w, 2) {
+			return nil
+		}
+	}
+	// w = x - ((x >> 1) & 0x5555555555555555)
+	if w.Op != OpSub64 {
+		return nil
+	}
+	x := w.Args[0]
+	if !isRsh64UxConst(constOperand(w.Args[1], OpAnd64, 0x5555555555555555), x, 1) {
+		return nil
+	}
+	return x
+}
+
+// constOperand returns x if v is (op x (Const64 [c])), with the
+// operands in either order, and nil otherwise.
+func constOperand(v *Value, op Op, c int64) *Value {
+	if v.Op != op {
+		return nil
+	}
+	for i, a := range v.Args {
+		if a.Op == OpConst64 && a.AuxInt == c {
+			return v.Args[1-i]
+		}
+	}
+	return nil
+}
+
+// isRsh64UxConst reports whether v is (Rsh64Ux64 x (Const64 [c])).
+func isRsh64UxConst(v, x *Value, c int64) bool {
+	return v != nil && v.Op == OpRsh64Ux64 && v.Args[0] == x && v.Args[1].Op == OpConst64 && v.Args[1].AuxInt == c
+}
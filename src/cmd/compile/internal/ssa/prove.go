@@ -595,6 +595,11 @@ func prove(f *Func) {
 				// taking this branch. We'll restore
 				// ft when we unwind.
 			}
+			// Facts about the phis of node.block are
+			// only known below it. They are unwound
+			// with ft after the block is simplified.
+			ft.checkpoint()
+			addPhiRestrictions(sdom, ft, parent, node.block)
 
 			work = append(work, bp{
 				block: node.block,
@@ -610,6 +615,7 @@ func prove(f *Func) {
 		case simplify:
 			simplifyBlock(sdom, ft, node.block)
 
+			ft.restore()
 			if branch != unknown {
 				popBranch(ft)
 			}
@@ -670,6 +676,103 @@ func popBranch(ft *factsTable) {
 	ft.restore()
 }
 
+// addPhiRestrictions learns facts about the integer phis of b, whose
+// predecessors are reached through the two branches of its immediate
+// dominator p. A relation between each argument of a phi and an operand
+// of p's comparison, given the branch the argument comes from, also
+// holds for the phi. This handles clamps such as
+//	if i >= n { i = n-1 }
+func addPhiRestrictions(sdom SparseTree, ft *factsTable, p, b *Block) {
+	if p == nil || p.Kind != BlockIf || len(b.Preds) != 2 {
+		return
+	}
+	c := p.Control
+	tr, has := domainRelationTable[c.Op]
+	if !has {
+		return
+	}
+	var brs [2]branch
+	for i, e := range b.Preds {
+		if e.b == p {
+			brs[i] = positive
+			if e.i != 0 {
+				brs[i] = negative
+			}
+		} else {
+			brs[i] = getBranch(sdom, p, e.b)
+		}
+		if brs[i] == unknown {
+			return
+		}
+	}
+	for _, v := range b.Values {
+		if v.Op != OpPhi || !v.Type.IsInteger() {
+			continue
+		}
+		for d := signed; d <= unsigned; d <<= 1 {
+			if tr.d&d == 0 {
+				continue
+			}
+			for _, w := range c.Args {
+				var r relation
+				for i, a := range v.Args {
+					r |= phiArgRelation(ft, p, brs[i], a, w, d)
+				}
+				// r is 0 only if no argument can flow
+				// into v; leave that to the branches.
+				if r != 0 && r != lt|eq|gt {
+					ft.update(b, v, w, d, r)
+				}
+			}
+		}
+	}
+}
+
+// phiArgRelation returns the relations between a and w in domain d
+// that are possible when p branches in direction br.
+func phiArgRelation(ft *factsTable, p *Block, br branch, a, w *Value, d domain) relation {
+	if a.isGenericIntConst() && w.isGenericIntConst() {
+		// The facts table does not know the limits of constants.
+		x, y := a.AuxInt, w.AuxInt
+		if d == unsigned {
+			if x, y := uint64(x)&sizeMask(a), uint64(y)&sizeMask(w); x != y {
+				if x < y {
+					return lt
+				}
+				return gt
+			}
+			return eq
+		}
+		switch {
+		case x < y:
+			return lt
+		case x > y:
+			return gt
+		}
+		return eq
+	}
+	if !tryPushBranch(ft, p, br) {
+		// a never flows into the phi.
+		return 0
+	}
+	var r relation
+	for _, s := range [...]relation{lt, eq, gt} {
+		ft.checkpoint()
+		ft.update(p, a, w, d, s)
+		if !ft.unsat {
+			r |= s
+		}
+		ft.restore()
+	}
+	popBranch(ft)
+	return r
+}
+
+// sizeMask returns a mask of the bits of the constant c's type.
+func sizeMask(c *Value) uint64 {
+	return 1<<uint(8*c.Type.Size()) - 1
+}
+
 // updateRestrictions updates restrictions from the immediate
 // dominating block (p) using r. r is adjusted according to the branch taken.
 func updateRestrictions(parent *Block, ft *factsTable, t domain, v, w *Value, r relation, branch branch) {
@@ -740,34 +843,49 @@ func updateRestrictions(parent *Block, ft *factsTable, t domain, v, w *Value, r
 	}
 }
 
+var ctzNonZeroOp = map[Op]Op{OpCtz32: OpCtz32NonZero, OpCtz64: OpCtz64NonZero}
+
 // simplifyBlock simplifies some constant values in b and evaluates
 // branches to non-uniquely dominated successors of b.
 func simplifyBlock(sdom SparseTree, ft *factsTable, b *Block) {
-	// Replace OpSlicemask operations in b with constants where possible.
 	for _, v := range b.Values {
-		if v.Op != OpSlicemask {
-			continue
-		}
-		x, delta := isConstDelta(v.Args[0])
-		if x == nil {
-			continue
-		}
-		// slicemask(x + y)
-		// if x is larger than -y (y is negative), then slicemask is -1.
-		lim, ok := ft.limits[x.ID]
-		if !ok {
-			continue
-		}
-		if lim.umin > uint64(-delta) {
-			if v.Args[0].Op == OpAdd64 {
-				v.reset(OpConst64)
-			} else {
-				v.reset(OpConst32)
+		switch v.Op {
+		case OpSlicemask:
+			// Replace OpSlicemask operations in b with constants where possible.
+			x, delta := isConstDelta(v.Args[0])
+			if x == nil {
+				continue
+			}
+			// slicemask(x + y)
+			// if x is larger than -y (y is negative), then slicemask is -1.
+			lim, ok := ft.limits[x.ID]
+			if !ok {
+				continue
 			}
-			if b.Func.pass.debug > 0 {
-				b.Func.Warnl(v.Pos, "Proved slicemask not needed")
+			if lim.umin > uint64(-delta) {
+				if v.Args[0].Op == OpAdd64 {
+					v.reset(OpConst64)
+				} else {
+					v.reset(OpConst32)
+				}
+				if b.Func.pass.debug > 0 {
+					b.Func.Warnl(v.Pos, "Proved slicemask not needed")
+				}
+				v.AuxInt = -1
+			}
+		case OpCtz32, OpCtz64:
+			// On some architectures, notably amd64, we can generate much
+			// better code for CtzNN if we know that the argument is non-zero.
+			lim, ok := ft.limits[v.Args[0].ID]
+			if !ok {
+				continue
+			}
+			if lim.umin > 0 || lim.min > 0 || lim.max < 0 {
+				if b.Func.pass.debug > 0 {
+					b.Func.Warnl(v.Pos, "Proved %v non-zero", v.Op)
+				}
+				v.Op = ctzNonZeroOp[v.Op]
 			}
-			v.AuxInt = -1
 		}
 	}
 
@@ -831,7 +949,8 @@ func isNonNegative(v *Value) bool {
 		return int32(v.AuxInt) >= 0
 
 	case OpStringLen, OpSliceLen, OpSliceCap,
-		OpZeroExt8to64, OpZeroExt16to64, OpZeroExt32to64:
+		OpZeroExt8to64, OpZeroExt16to64, OpZeroExt32to64,
+		OpPopCount8, OpPopCount16, OpPopCount32, OpPopCount64:
 		return true
 
 	case OpRsh64x64:
@@ -278,6 +278,13 @@ func isSameSym(sym interface{}, name string) bool {
 	return ok && s.String() == name
 }
 
+// isByteParityFold reports whether a, b and c are the shifts 1, 2 and 4
+// in some order, so that x^=x>>a; x^=x>>b; x^=x>>c leaves the parity of
+// the low byte of x in bit 0.
+func isByteParityFold(a, b, c int64) bool {
+	return a > 0 && b > 0 && c > 0 && a|b|c == 7 && a+b+c == 7
+}
+
 // nlz returns the number of leading zeros.
 func nlz(x int64) int64 {
 	// log2(0) == 1, so nlz(0) == 64
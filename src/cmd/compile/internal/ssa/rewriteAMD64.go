@@ -42,13 +42,13 @@ func rewriteValueAMD64(v *Value) bool {
 	case OpAMD64ANDL:
 		return rewriteValueAMD64_OpAMD64ANDL_0(v)
 	case OpAMD64ANDLconst:
-		return rewriteValueAMD64_OpAMD64ANDLconst_0(v)
+		return rewriteValueAMD64_OpAMD64ANDLconst_0(v) || rewriteValueAMD64_OpAMD64ANDLconst_10(v) || rewriteValueAMD64_OpAMD64ANDLconst_20(v) || rewriteValueAMD64_OpAMD64ANDLconst_30(v)
 	case OpAMD64ANDLmem:
 		return rewriteValueAMD64_OpAMD64ANDLmem_0(v)
 	case OpAMD64ANDQ:
 		return rewriteValueAMD64_OpAMD64ANDQ_0(v)
 	case OpAMD64ANDQconst:
-		return rewriteValueAMD64_OpAMD64ANDQconst_0(v)
+		return rewriteValueAMD64_OpAMD64ANDQconst_0(v) || rewriteValueAMD64_OpAMD64ANDQconst_10(v)
 	case OpAMD64ANDQmem:
 		return rewriteValueAMD64_OpAMD64ANDQmem_0(v)
 	case OpAMD64BSFQ:
@@ -558,7 +558,7 @@ func rewriteValueAMD64(v *Value) bool {
 	case OpCom8:
 		return rewriteValueAMD64_OpCom8_0(v)
 	case OpCondSelect:
-		return rewriteValueAMD64_OpCondSelect_0(v) || rewriteValueAMD64_OpCondSelect_10(v) || rewriteValueAMD64_OpCondSelect_20(v) || rewriteValueAMD64_OpCondSelect_30(v) || rewriteValueAMD64_OpCondSelect_40(v)
+		return rewriteValueAMD64_OpCondSelect_0(v) || rewriteValueAMD64_OpCondSelect_10(v) || rewriteValueAMD64_OpCondSelect_20(v) || rewriteValueAMD64_OpCondSelect_30(v) || rewriteValueAMD64_OpCondSelect_40(v) || rewriteValueAMD64_OpCondSelect_50(v)
 	case OpConst16:
 		return rewriteValueAMD64_OpConst16_0(v)
 	case OpConst32:
@@ -577,10 +577,16 @@ func rewriteValueAMD64(v *Value) bool {
 		return rewriteValueAMD64_OpConstNil_0(v)
 	case OpConvert:
 		return rewriteValueAMD64_OpConvert_0(v)
+	case OpCopysign:
+		return rewriteValueAMD64_OpCopysign_0(v)
 	case OpCtz32:
 		return rewriteValueAMD64_OpCtz32_0(v)
+	case OpCtz32NonZero:
+		return rewriteValueAMD64_OpCtz32NonZero_0(v)
 	case OpCtz64:
 		return rewriteValueAMD64_OpCtz64_0(v)
+	case OpCtz64NonZero:
+		return rewriteValueAMD64_OpCtz64NonZero_0(v)
 	case OpCvt32Fto32:
 		return rewriteValueAMD64_OpCvt32Fto32_0(v)
 	case OpCvt32Fto64:
@@ -637,6 +643,8 @@ func rewriteValueAMD64(v *Value) bool {
 		return rewriteValueAMD64_OpEq8_0(v)
 	case OpEqB:
 		return rewriteValueAMD64_OpEqB_0(v)
+	case OpEqMem128:
+		return rewriteValueAMD64_OpEqMem128_0(v)
 	case OpEqPtr:
 		return rewriteValueAMD64_OpEqPtr_0(v)
 	case OpFloor:
@@ -2817,6 +2825,10 @@ func rewriteValueAMD64_OpAMD64ANDL_0(v *Value) bool {
 	return false
 }
 func rewriteValueAMD64_OpAMD64ANDLconst_0(v *Value) bool {
+	b := v.Block
+	_ = b
+	typ := &b.Func.Config.Types
+	_ = typ
 	// match: (ANDLconst [c] (ANDLconst [d] x))
 	// cond:
 	// result: (ANDLconst [c & d] x)
@@ -2897,6 +2909,1368 @@ func rewriteValueAMD64_OpAMD64ANDLconst_0(v *Value) bool {
 		v.AuxInt = c & d
 		return true
 	}
+	// match: (ANDLconst [1] (LoweredPopCount8 x))
+	// cond:
+	// result: (MOVBQZX (SETORD <typ.UInt8> (TESTB x x)))
+	for {
+		if v.AuxInt != 1 {
+			break
+		}
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64LoweredPopCount8 {
+			break
+		}
+		x := v_0.Args[0]
+		v.reset(OpAMD64MOVBQZX)
+		v0 := b.NewValue0(v.Pos, OpAMD64SETORD, typ.UInt8)
+		v1 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+		v1.AddArg(x)
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (ANDLconst [1] (XORL y:(XORL z:(XORL x (SHRLconst [s1] x)) (SHRLconst [s2] z2)) (SHRLconst [s3] y2)))
+	// cond: y == y2 && z == z2 && isByteParityFold(s1, s2, s3)
+	// result: (MOVBQZX (SETORD <typ.UInt8> (TESTB x x)))
+	for {
+		if v.AuxInt != 1 {
+			break
+		}
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64XORL {
+			break
+		}
+		_ = v_0.Args[1]
+		y := v_0.Args[0]
+		if y.Op != OpAMD64XORL {
+			break
+		}
+		_ = y.Args[1]
+		z := y.Args[0]
+		if z.Op != OpAMD64XORL {
+			break
+		}
+		_ = z.Args[1]
+		x := z.Args[0]
+		z_1 := z.Args[1]
+		if z_1.Op != OpAMD64SHRLconst {
+			break
+		}
+		s1 := z_1.AuxInt
+		if x != z_1.Args[0] {
+			break
+		}
+		y_1 := y.Args[1]
+		if y_1.Op != OpAMD64SHRLconst {
+			break
+		}
+		s2 := y_1.AuxInt
+		z2 := y_1.Args[0]
+		v_0_1 := v_0.Args[1]
+		if v_0_1.Op != OpAMD64SHRLconst {
+			break
+		}
+		s3 := v_0_1.AuxInt
+		y2 := v_0_1.Args[0]
+		if !(y == y2 && z == z2 && isByteParityFold(s1, s2, s3)) {
+			break
+		}
+		v.reset(OpAMD64MOVBQZX)
+		v0 := b.NewValue0(v.Pos, OpAMD64SETORD, typ.UInt8)
+		v1 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+		v1.AddArg(x)
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (ANDLconst [1] (XORL y:(XORL z:(XORL (SHRLconst [s1] x) x) (SHRLconst [s2] z2)) (SHRLconst [s3] y2)))
+	// cond: y == y2 && z == z2 && isByteParityFold(s1, s2, s3)
+	// result: (MOVBQZX (SETORD <typ.UInt8> (TESTB x x)))
+	for {
+		if v.AuxInt != 1 {
+			break
+		}
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64XORL {
+			break
+		}
+		_ = v_0.Args[1]
+		y := v_0.Args[0]
+		if y.Op != OpAMD64XORL {
+			break
+		}
+		_ = y.Args[1]
+		z := y.Args[0]
+		if z.Op != OpAMD64XORL {
+			break
+		}
+		_ = z.Args[1]
+		z_0 := z.Args[0]
+		if z_0.Op != OpAMD64SHRLconst {
+			break
+		}
+		s1 := z_0.AuxInt
+		x := z_0.Args[0]
+		if x != z.Args[1] {
+			break
+		}
+		y_1 := y.Args[1]
+		if y_1.Op != OpAMD64SHRLconst {
+			break
+		}
+		s2 := y_1.AuxInt
+		z2 := y_1.Args[0]
+		v_0_1 := v_0.Args[1]
+		if v_0_1.Op != OpAMD64SHRLconst {
+			break
+		}
+		s3 := v_0_1.AuxInt
+		y2 := v_0_1.Args[0]
+		if !(y == y2 && z == z2 && isByteParityFold(s1, s2, s3)) {
+			break
+		}
+		v.reset(OpAMD64MOVBQZX)
+		v0 := b.NewValue0(v.Pos, OpAMD64SETORD, typ.UInt8)
+		v1 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+		v1.AddArg(x)
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (ANDLconst [1] (XORL y:(XORL (SHRLconst [s2] z2) z:(XORL x (SHRLconst [s1] x))) (SHRLconst [s3] y2)))
+	// cond: y == y2 && z == z2 && isByteParityFold(s1, s2, s3)
+	// result: (MOVBQZX (SETORD <typ.UInt8> (TESTB x x)))
+	for {
+		if v.AuxInt != 1 {
+			break
+		}
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64XORL {
+			break
+		}
+		_ = v_0.Args[1]
+		y := v_0.Args[0]
+		if y.Op != OpAMD64XORL {
+			break
+		}
+		_ = y.Args[1]
+		y_0 := y.Args[0]
+		if y_0.Op != OpAMD64SHRLconst {
+			break
+		}
+		s2 := y_0.AuxInt
+		z2 := y_0.Args[0]
+		z := y.Args[1]
+		if z.Op != OpAMD64XORL {
+			break
+		}
+		_ = z.Args[1]
+		x := z.Args[0]
+		z_1 := z.Args[1]
+		if z_1.Op != OpAMD64SHRLconst {
+			break
+		}
+		s1 := z_1.AuxInt
+		if x != z_1.Args[0] {
+			break
+		}
+		v_0_1 := v_0.Args[1]
+		if v_0_1.Op != OpAMD64SHRLconst {
+			break
+		}
+		s3 := v_0_1.AuxInt
+		y2 := v_0_1.Args[0]
+		if !(y == y2 && z == z2 && isByteParityFold(s1, s2, s3)) {
+			break
+		}
+		v.reset(OpAMD64MOVBQZX)
+		v0 := b.NewValue0(v.Pos, OpAMD64SETORD, typ.UInt8)
+		v1 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+		v1.AddArg(x)
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+	return false
+}
+func rewriteValueAMD64_OpAMD64ANDLconst_10(v *Value) bool {
+	b := v.Block
+	_ = b
+	typ := &b.Func.Config.Types
+	_ = typ
+	// match: (ANDLconst [1] (XORL y:(XORL (SHRLconst [s2] z2) z:(XORL (SHRLconst [s1] x) x)) (SHRLconst [s3] y2)))
+	// cond: y == y2 && z == z2 && isByteParityFold(s1, s2, s3)
+	// result: (MOVBQZX (SETORD <typ.UInt8> (TESTB x x)))
+	for {
+		if v.AuxInt != 1 {
+			break
+		}
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64XORL {
+			break
+		}
+		_ = v_0.Args[1]
+		y := v_0.Args[0]
+		if y.Op != OpAMD64XORL {
+			break
+		}
+		_ = y.Args[1]
+		y_0 := y.Args[0]
+		if y_0.Op != OpAMD64SHRLconst {
+			break
+		}
+		s2 := y_0.AuxInt
+		z2 := y_0.Args[0]
+		z := y.Args[1]
+		if z.Op != OpAMD64XORL {
+			break
+		}
+		_ = z.Args[1]
+		z_0 := z.Args[0]
+		if z_0.Op != OpAMD64SHRLconst {
+			break
+		}
+		s1 := z_0.AuxInt
+		x := z_0.Args[0]
+		if x != z.Args[1] {
+			break
+		}
+		v_0_1 := v_0.Args[1]
+		if v_0_1.Op != OpAMD64SHRLconst {
+			break
+		}
+		s3 := v_0_1.AuxInt
+		y2 := v_0_1.Args[0]
+		if !(y == y2 && z == z2 && isByteParityFold(s1, s2, s3)) {
+			break
+		}
+		v.reset(OpAMD64MOVBQZX)
+		v0 := b.NewValue0(v.Pos, OpAMD64SETORD, typ.UInt8)
+		v1 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+		v1.AddArg(x)
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (ANDLconst [1] (XORL (SHRLconst [s3] y2) y:(XORL z:(XORL x (SHRLconst [s1] x)) (SHRLconst [s2] z2))))
+	// cond: y == y2 && z == z2 && isByteParityFold(s1, s2, s3)
+	// result: (MOVBQZX (SETORD <typ.UInt8> (TESTB x x)))
+	for {
+		if v.AuxInt != 1 {
+			break
+		}
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64XORL {
+			break
+		}
+		_ = v_0.Args[1]
+		v_0_0 := v_0.Args[0]
+		if v_0_0.Op != OpAMD64SHRLconst {
+			break
+		}
+		s3 := v_0_0.AuxInt
+		y2 := v_0_0.Args[0]
+		y := v_0.Args[1]
+		if y.Op != OpAMD64XORL {
+			break
+		}
+		_ = y.Args[1]
+		z := y.Args[0]
+		if z.Op != OpAMD64XORL {
+			break
+		}
+		_ = z.Args[1]
+		x := z.Args[0]
+		z_1 := z.Args[1]
+		if z_1.Op != OpAMD64SHRLconst {
+			break
+		}
+		s1 := z_1.AuxInt
+		if x != z_1.Args[0] {
+			break
+		}
+		y_1 := y.Args[1]
+		if y_1.Op != OpAMD64SHRLconst {
+			break
+		}
+		s2 := y_1.AuxInt
+		z2 := y_1.Args[0]
+		if !(y == y2 && z == z2 && isByteParityFold(s1, s2, s3)) {
+			break
+		}
+		v.reset(OpAMD64MOVBQZX)
+		v0 := b.NewValue0(v.Pos, OpAMD64SETORD, typ.UInt8)
+		v1 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+		v1.AddArg(x)
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (ANDLconst [1] (XORL (SHRLconst [s3] y2) y:(XORL z:(XORL (SHRLconst [s1] x) x) (SHRLconst [s2] z2))))
+	// cond: y == y2 && z == z2 && isByteParityFold(s1, s2, s3)
+	// result: (MOVBQZX (SETORD <typ.UInt8> (TESTB x x)))
+	for {
+		if v.AuxInt != 1 {
+			break
+		}
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64XORL {
+			break
+		}
+		_ = v_0.Args[1]
+		v_0_0 := v_0.Args[0]
+		if v_0_0.Op != OpAMD64SHRLconst {
+			break
+		}
+		s3 := v_0_0.AuxInt
+		y2 := v_0_0.Args[0]
+		y := v_0.Args[1]
+		if y.Op != OpAMD64XORL {
+			break
+		}
+		_ = y.Args[1]
+		z := y.Args[0]
+		if z.Op != OpAMD64XORL {
+			break
+		}
+		_ = z.Args[1]
+		z_0 := z.Args[0]
+		if z_0.Op != OpAMD64SHRLconst {
+			break
+		}
+		s1 := z_0.AuxInt
+		x := z_0.Args[0]
+		if x != z.Args[1] {
+			break
+		}
+		y_1 := y.Args[1]
+		if y_1.Op != OpAMD64SHRLconst {
+			break
+		}
+		s2 := y_1.AuxInt
+		z2 := y_1.Args[0]
+		if !(y == y2 && z == z2 && isByteParityFold(s1, s2, s3)) {
+			break
+		}
+		v.reset(OpAMD64MOVBQZX)
+		v0 := b.NewValue0(v.Pos, OpAMD64SETORD, typ.UInt8)
+		v1 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+		v1.AddArg(x)
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (ANDLconst [1] (XORL (SHRLconst [s3] y2) y:(XORL (SHRLconst [s2] z2) z:(XORL x (SHRLconst [s1] x)))))
+	// cond: y == y2 && z == z2 && isByteParityFold(s1, s2, s3)
+	// result: (MOVBQZX (SETORD <typ.UInt8> (TESTB x x)))
+	for {
+		if v.AuxInt != 1 {
+			break
+		}
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64XORL {
+			break
+		}
+		_ = v_0.Args[1]
+		v_0_0 := v_0.Args[0]
+		if v_0_0.Op != OpAMD64SHRLconst {
+			break
+		}
+		s3 := v_0_0.AuxInt
+		y2 := v_0_0.Args[0]
+		y := v_0.Args[1]
+		if y.Op != OpAMD64XORL {
+			break
+		}
+		_ = y.Args[1]
+		y_0 := y.Args[0]
+		if y_0.Op != OpAMD64SHRLconst {
+			break
+		}
+		s2 := y_0.AuxInt
+		z2 := y_0.Args[0]
+		z := y.Args[1]
+		if z.Op != OpAMD64XORL {
+			break
+		}
+		_ = z.Args[1]
+		x := z.Args[0]
+		z_1 := z.Args[1]
+		if z_1.Op != OpAMD64SHRLconst {
+			break
+		}
+		s1 := z_1.AuxInt
+		if x != z_1.Args[0] {
+			break
+		}
+		if !(y == y2 && z == z2 && isByteParityFold(s1, s2, s3)) {
+			break
+		}
+		v.reset(OpAMD64MOVBQZX)
+		v0 := b.NewValue0(v.Pos, OpAMD64SETORD, typ.UInt8)
+		v1 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+		v1.AddArg(x)
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (ANDLconst [1] (XORL (SHRLconst [s3] y2) y:(XORL (SHRLconst [s2] z2) z:(XORL (SHRLconst [s1] x) x))))
+	// cond: y == y2 && z == z2 && isByteParityFold(s1, s2, s3)
+	// result: (MOVBQZX (SETORD <typ.UInt8> (TESTB x x)))
+	for {
+		if v.AuxInt != 1 {
+			break
+		}
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64XORL {
+			break
+		}
+		_ = v_0.Args[1]
+		v_0_0 := v_0.Args[0]
+		if v_0_0.Op != OpAMD64SHRLconst {
+			break
+		}
+		s3 := v_0_0.AuxInt
+		y2 := v_0_0.Args[0]
+		y := v_0.Args[1]
+		if y.Op != OpAMD64XORL {
+			break
+		}
+		_ = y.Args[1]
+		y_0 := y.Args[0]
+		if y_0.Op != OpAMD64SHRLconst {
+			break
+		}
+		s2 := y_0.AuxInt
+		z2 := y_0.Args[0]
+		z := y.Args[1]
+		if z.Op != OpAMD64XORL {
+			break
+		}
+		_ = z.Args[1]
+		z_0 := z.Args[0]
+		if z_0.Op != OpAMD64SHRLconst {
+			break
+		}
+		s1 := z_0.AuxInt
+		x := z_0.Args[0]
+		if x != z.Args[1] {
+			break
+		}
+		if !(y == y2 && z == z2 && isByteParityFold(s1, s2, s3)) {
+			break
+		}
+		v.reset(OpAMD64MOVBQZX)
+		v0 := b.NewValue0(v.Pos, OpAMD64SETORD, typ.UInt8)
+		v1 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+		v1.AddArg(x)
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (ANDLconst [1] (XORL y:(XORL z:(XORL x (SHRWconst [s1] x)) (SHRWconst [s2] z2)) (SHRWconst [s3] y2)))
+	// cond: y == y2 && z == z2 && isByteParityFold(s1, s2, s3)
+	// result: (MOVBQZX (SETORD <typ.UInt8> (TESTB x x)))
+	for {
+		if v.AuxInt != 1 {
+			break
+		}
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64XORL {
+			break
+		}
+		_ = v_0.Args[1]
+		y := v_0.Args[0]
+		if y.Op != OpAMD64XORL {
+			break
+		}
+		_ = y.Args[1]
+		z := y.Args[0]
+		if z.Op != OpAMD64XORL {
+			break
+		}
+		_ = z.Args[1]
+		x := z.Args[0]
+		z_1 := z.Args[1]
+		if z_1.Op != OpAMD64SHRWconst {
+			break
+		}
+		s1 := z_1.AuxInt
+		if x != z_1.Args[0] {
+			break
+		}
+		y_1 := y.Args[1]
+		if y_1.Op != OpAMD64SHRWconst {
+			break
+		}
+		s2 := y_1.AuxInt
+		z2 := y_1.Args[0]
+		v_0_1 := v_0.Args[1]
+		if v_0_1.Op != OpAMD64SHRWconst {
+			break
+		}
+		s3 := v_0_1.AuxInt
+		y2 := v_0_1.Args[0]
+		if !(y == y2 && z == z2 && isByteParityFold(s1, s2, s3)) {
+			break
+		}
+		v.reset(OpAMD64MOVBQZX)
+		v0 := b.NewValue0(v.Pos, OpAMD64SETORD, typ.UInt8)
+		v1 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+		v1.AddArg(x)
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (ANDLconst [1] (XORL y:(XORL z:(XORL (SHRWconst [s1] x) x) (SHRWconst [s2] z2)) (SHRWconst [s3] y2)))
+	// cond: y == y2 && z == z2 && isByteParityFold(s1, s2, s3)
+	// result: (MOVBQZX (SETORD <typ.UInt8> (TESTB x x)))
+	for {
+		if v.AuxInt != 1 {
+			break
+		}
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64XORL {
+			break
+		}
+		_ = v_0.Args[1]
+		y := v_0.Args[0]
+		if y.Op != OpAMD64XORL {
+			break
+		}
+		_ = y.Args[1]
+		z := y.Args[0]
+		if z.Op != OpAMD64XORL {
+			break
+		}
+		_ = z.Args[1]
+		z_0 := z.Args[0]
+		if z_0.Op != OpAMD64SHRWconst {
+			break
+		}
+		s1 := z_0.AuxInt
+		x := z_0.Args[0]
+		if x != z.Args[1] {
+			break
+		}
+		y_1 := y.Args[1]
+		if y_1.Op != OpAMD64SHRWconst {
+			break
+		}
+		s2 := y_1.AuxInt
+		z2 := y_1.Args[0]
+		v_0_1 := v_0.Args[1]
+		if v_0_1.Op != OpAMD64SHRWconst {
+			break
+		}
+		s3 := v_0_1.AuxInt
+		y2 := v_0_1.Args[0]
+		if !(y == y2 && z == z2 && isByteParityFold(s1, s2, s3)) {
+			break
+		}
+		v.reset(OpAMD64MOVBQZX)
+		v0 := b.NewValue0(v.Pos, OpAMD64SETORD, typ.UInt8)
+		v1 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+		v1.AddArg(x)
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (ANDLconst [1] (XORL y:(XORL (SHRWconst [s2] z2) z:(XORL x (SHRWconst [s1] x))) (SHRWconst [s3] y2)))
+	// cond: y == y2 && z == z2 && isByteParityFold(s1, s2, s3)
+	// result: (MOVBQZX (SETORD <typ.UInt8> (TESTB x x)))
+	for {
+		if v.AuxInt != 1 {
+			break
+		}
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64XORL {
+			break
+		}
+		_ = v_0.Args[1]
+		y := v_0.Args[0]
+		if y.Op != OpAMD64XORL {
+			break
+		}
+		_ = y.Args[1]
+		y_0 := y.Args[0]
+		if y_0.Op != OpAMD64SHRWconst {
+			break
+		}
+		s2 := y_0.AuxInt
+		z2 := y_0.Args[0]
+		z := y.Args[1]
+		if z.Op != OpAMD64XORL {
+			break
+		}
+		_ = z.Args[1]
+		x := z.Args[0]
+		z_1 := z.Args[1]
+		if z_1.Op != OpAMD64SHRWconst {
+			break
+		}
+		s1 := z_1.AuxInt
+		if x != z_1.Args[0] {
+			break
+		}
+		v_0_1 := v_0.Args[1]
+		if v_0_1.Op != OpAMD64SHRWconst {
+			break
+		}
+		s3 := v_0_1.AuxInt
+		y2 := v_0_1.Args[0]
+		if !(y == y2 && z == z2 && isByteParityFold(s1, s2, s3)) {
+			break
+		}
+		v.reset(OpAMD64MOVBQZX)
+		v0 := b.NewValue0(v.Pos, OpAMD64SETORD, typ.UInt8)
+		v1 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+		v1.AddArg(x)
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (ANDLconst [1] (XORL y:(XORL (SHRWconst [s2] z2) z:(XORL (SHRWconst [s1] x) x)) (SHRWconst [s3] y2)))
+	// cond: y == y2 && z == z2 && isByteParityFold(s1, s2, s3)
+	// result: (MOVBQZX (SETORD <typ.UInt8> (TESTB x x)))
+	for {
+		if v.AuxInt != 1 {
+			break
+		}
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64XORL {
+			break
+		}
+		_ = v_0.Args[1]
+		y := v_0.Args[0]
+		if y.Op != OpAMD64XORL {
+			break
+		}
+		_ = y.Args[1]
+		y_0 := y.Args[0]
+		if y_0.Op != OpAMD64SHRWconst {
+			break
+		}
+		s2 := y_0.AuxInt
+		z2 := y_0.Args[0]
+		z := y.Args[1]
+		if z.Op != OpAMD64XORL {
+			break
+		}
+		_ = z.Args[1]
+		z_0 := z.Args[0]
+		if z_0.Op != OpAMD64SHRWconst {
+			break
+		}
+		s1 := z_0.AuxInt
+		x := z_0.Args[0]
+		if x != z.Args[1] {
+			break
+		}
+		v_0_1 := v_0.Args[1]
+		if v_0_1.Op != OpAMD64SHRWconst {
+			break
+		}
+		s3 := v_0_1.AuxInt
+		y2 := v_0_1.Args[0]
+		if !(y == y2 && z == z2 && isByteParityFold(s1, s2, s3)) {
+			break
+		}
+		v.reset(OpAMD64MOVBQZX)
+		v0 := b.NewValue0(v.Pos, OpAMD64SETORD, typ.UInt8)
+		v1 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+		v1.AddArg(x)
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (ANDLconst [1] (XORL (SHRWconst [s3] y2) y:(XORL z:(XORL x (SHRWconst [s1] x)) (SHRWconst [s2] z2))))
+	// cond: y == y2 && z == z2 && isByteParityFold(s1, s2, s3)
+	// result: (MOVBQZX (SETORD <typ.UInt8> (TESTB x x)))
+	for {
+		if v.AuxInt != 1 {
+			break
+		}
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64XORL {
+			break
+		}
+		_ = v_0.Args[1]
+		v_0_0 := v_0.Args[0]
+		if v_0_0.Op != OpAMD64SHRWconst {
+			break
+		}
+		s3 := v_0_0.AuxInt
+		y2 := v_0_0.Args[0]
+		y := v_0.Args[1]
+		if y.Op != OpAMD64XORL {
+			break
+		}
+		_ = y.Args[1]
+		z := y.Args[0]
+		if z.Op != OpAMD64XORL {
+			break
+		}
+		_ = z.Args[1]
+		x := z.Args[0]
+		z_1 := z.Args[1]
+		if z_1.Op != OpAMD64SHRWconst {
+			break
+		}
+		s1 := z_1.AuxInt
+		if x != z_1.Args[0] {
+			break
+		}
+		y_1 := y.Args[1]
+		if y_1.Op != OpAMD64SHRWconst {
+			break
+		}
+		s2 := y_1.AuxInt
+		z2 := y_1.Args[0]
+		if !(y == y2 && z == z2 && isByteParityFold(s1, s2, s3)) {
+			break
+		}
+		v.reset(OpAMD64MOVBQZX)
+		v0 := b.NewValue0(v.Pos, OpAMD64SETORD, typ.UInt8)
+		v1 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+		v1.AddArg(x)
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+	return false
+}
+func rewriteValueAMD64_OpAMD64ANDLconst_20(v *Value) bool {
+	b := v.Block
+	_ = b
+	typ := &b.Func.Config.Types
+	_ = typ
+	// match: (ANDLconst [1] (XORL (SHRWconst [s3] y2) y:(XORL z:(XORL (SHRWconst [s1] x) x) (SHRWconst [s2] z2))))
+	// cond: y == y2 && z == z2 && isByteParityFold(s1, s2, s3)
+	// result: (MOVBQZX (SETORD <typ.UInt8> (TESTB x x)))
+	for {
+		if v.AuxInt != 1 {
+			break
+		}
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64XORL {
+			break
+		}
+		_ = v_0.Args[1]
+		v_0_0 := v_0.Args[0]
+		if v_0_0.Op != OpAMD64SHRWconst {
+			break
+		}
+		s3 := v_0_0.AuxInt
+		y2 := v_0_0.Args[0]
+		y := v_0.Args[1]
+		if y.Op != OpAMD64XORL {
+			break
+		}
+		_ = y.Args[1]
+		z := y.Args[0]
+		if z.Op != OpAMD64XORL {
+			break
+		}
+		_ = z.Args[1]
+		z_0 := z.Args[0]
+		if z_0.Op != OpAMD64SHRWconst {
+			break
+		}
+		s1 := z_0.AuxInt
+		x := z_0.Args[0]
+		if x != z.Args[1] {
+			break
+		}
+		y_1 := y.Args[1]
+		if y_1.Op != OpAMD64SHRWconst {
+			break
+		}
+		s2 := y_1.AuxInt
+		z2 := y_1.Args[0]
+		if !(y == y2 && z == z2 && isByteParityFold(s1, s2, s3)) {
+			break
+		}
+		v.reset(OpAMD64MOVBQZX)
+		v0 := b.NewValue0(v.Pos, OpAMD64SETORD, typ.UInt8)
+		v1 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+		v1.AddArg(x)
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (ANDLconst [1] (XORL (SHRWconst [s3] y2) y:(XORL (SHRWconst [s2] z2) z:(XORL x (SHRWconst [s1] x)))))
+	// cond: y == y2 && z == z2 && isByteParityFold(s1, s2, s3)
+	// result: (MOVBQZX (SETORD <typ.UInt8> (TESTB x x)))
+	for {
+		if v.AuxInt != 1 {
+			break
+		}
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64XORL {
+			break
+		}
+		_ = v_0.Args[1]
+		v_0_0 := v_0.Args[0]
+		if v_0_0.Op != OpAMD64SHRWconst {
+			break
+		}
+		s3 := v_0_0.AuxInt
+		y2 := v_0_0.Args[0]
+		y := v_0.Args[1]
+		if y.Op != OpAMD64XORL {
+			break
+		}
+		_ = y.Args[1]
+		y_0 := y.Args[0]
+		if y_0.Op != OpAMD64SHRWconst {
+			break
+		}
+		s2 := y_0.AuxInt
+		z2 := y_0.Args[0]
+		z := y.Args[1]
+		if z.Op != OpAMD64XORL {
+			break
+		}
+		_ = z.Args[1]
+		x := z.Args[0]
+		z_1 := z.Args[1]
+		if z_1.Op != OpAMD64SHRWconst {
+			break
+		}
+		s1 := z_1.AuxInt
+		if x != z_1.Args[0] {
+			break
+		}
+		if !(y == y2 && z == z2 && isByteParityFold(s1, s2, s3)) {
+			break
+		}
+		v.reset(OpAMD64MOVBQZX)
+		v0 := b.NewValue0(v.Pos, OpAMD64SETORD, typ.UInt8)
+		v1 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+		v1.AddArg(x)
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (ANDLconst [1] (XORL (SHRWconst [s3] y2) y:(XORL (SHRWconst [s2] z2) z:(XORL (SHRWconst [s1] x) x))))
+	// cond: y == y2 && z == z2 && isByteParityFold(s1, s2, s3)
+	// result: (MOVBQZX (SETORD <typ.UInt8> (TESTB x x)))
+	for {
+		if v.AuxInt != 1 {
+			break
+		}
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64XORL {
+			break
+		}
+		_ = v_0.Args[1]
+		v_0_0 := v_0.Args[0]
+		if v_0_0.Op != OpAMD64SHRWconst {
+			break
+		}
+		s3 := v_0_0.AuxInt
+		y2 := v_0_0.Args[0]
+		y := v_0.Args[1]
+		if y.Op != OpAMD64XORL {
+			break
+		}
+		_ = y.Args[1]
+		y_0 := y.Args[0]
+		if y_0.Op != OpAMD64SHRWconst {
+			break
+		}
+		s2 := y_0.AuxInt
+		z2 := y_0.Args[0]
+		z := y.Args[1]
+		if z.Op != OpAMD64XORL {
+			break
+		}
+		_ = z.Args[1]
+		z_0 := z.Args[0]
+		if z_0.Op != OpAMD64SHRWconst {
+			break
+		}
+		s1 := z_0.AuxInt
+		x := z_0.Args[0]
+		if x != z.Args[1] {
+			break
+		}
+		if !(y == y2 && z == z2 && isByteParityFold(s1, s2, s3)) {
+			break
+		}
+		v.reset(OpAMD64MOVBQZX)
+		v0 := b.NewValue0(v.Pos, OpAMD64SETORD, typ.UInt8)
+		v1 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+		v1.AddArg(x)
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (ANDLconst [1] (XORL y:(XORL z:(XORL x (SHRBconst [s1] x)) (SHRBconst [s2] z2)) (SHRBconst [s3] y2)))
+	// cond: y == y2 && z == z2 && isByteParityFold(s1, s2, s3)
+	// result: (MOVBQZX (SETORD <typ.UInt8> (TESTB x x)))
+	for {
+		if v.AuxInt != 1 {
+			break
+		}
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64XORL {
+			break
+		}
+		_ = v_0.Args[1]
+		y := v_0.Args[0]
+		if y.Op != OpAMD64XORL {
+			break
+		}
+		_ = y.Args[1]
+		z := y.Args[0]
+		if z.Op != OpAMD64XORL {
+			break
+		}
+		_ = z.Args[1]
+		x := z.Args[0]
+		z_1 := z.Args[1]
+		if z_1.Op != OpAMD64SHRBconst {
+			break
+		}
+		s1 := z_1.AuxInt
+		if x != z_1.Args[0] {
+			break
+		}
+		y_1 := y.Args[1]
+		if y_1.Op != OpAMD64SHRBconst {
+			break
+		}
+		s2 := y_1.AuxInt
+		z2 := y_1.Args[0]
+		v_0_1 := v_0.Args[1]
+		if v_0_1.Op != OpAMD64SHRBconst {
+			break
+		}
+		s3 := v_0_1.AuxInt
+		y2 := v_0_1.Args[0]
+		if !(y == y2 && z == z2 && isByteParityFold(s1, s2, s3)) {
+			break
+		}
+		v.reset(OpAMD64MOVBQZX)
+		v0 := b.NewValue0(v.Pos, OpAMD64SETORD, typ.UInt8)
+		v1 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+		v1.AddArg(x)
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (ANDLconst [1] (XORL y:(XORL z:(XORL (SHRBconst [s1] x) x) (SHRBconst [s2] z2)) (SHRBconst [s3] y2)))
+	// cond: y == y2 && z == z2 && isByteParityFold(s1, s2, s3)
+	// result: (MOVBQZX (SETORD <typ.UInt8> (TESTB x x)))
+	for {
+		if v.AuxInt != 1 {
+			break
+		}
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64XORL {
+			break
+		}
+		_ = v_0.Args[1]
+		y := v_0.Args[0]
+		if y.Op != OpAMD64XORL {
+			break
+		}
+		_ = y.Args[1]
+		z := y.Args[0]
+		if z.Op != OpAMD64XORL {
+			break
+		}
+		_ = z.Args[1]
+		z_0 := z.Args[0]
+		if z_0.Op != OpAMD64SHRBconst {
+			break
+		}
+		s1 := z_0.AuxInt
+		x := z_0.Args[0]
+		if x != z.Args[1] {
+			break
+		}
+		y_1 := y.Args[1]
+		if y_1.Op != OpAMD64SHRBconst {
+			break
+		}
+		s2 := y_1.AuxInt
+		z2 := y_1.Args[0]
+		v_0_1 := v_0.Args[1]
+		if v_0_1.Op != OpAMD64SHRBconst {
+			break
+		}
+		s3 := v_0_1.AuxInt
+		y2 := v_0_1.Args[0]
+		if !(y == y2 && z == z2 && isByteParityFold(s1, s2, s3)) {
+			break
+		}
+		v.reset(OpAMD64MOVBQZX)
+		v0 := b.NewValue0(v.Pos, OpAMD64SETORD, typ.UInt8)
+		v1 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+		v1.AddArg(x)
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (ANDLconst [1] (XORL y:(XORL (SHRBconst [s2] z2) z:(XORL x (SHRBconst [s1] x))) (SHRBconst [s3] y2)))
+	// cond: y == y2 && z == z2 && isByteParityFold(s1, s2, s3)
+	// result: (MOVBQZX (SETORD <typ.UInt8> (TESTB x x)))
+	for {
+		if v.AuxInt != 1 {
+			break
+		}
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64XORL {
+			break
+		}
+		_ = v_0.Args[1]
+		y := v_0.Args[0]
+		if y.Op != OpAMD64XORL {
+			break
+		}
+		_ = y.Args[1]
+		y_0 := y.Args[0]
+		if y_0.Op != OpAMD64SHRBconst {
+			break
+		}
+		s2 := y_0.AuxInt
+		z2 := y_0.Args[0]
+		z := y.Args[1]
+		if z.Op != OpAMD64XORL {
+			break
+		}
+		_ = z.Args[1]
+		x := z.Args[0]
+		z_1 := z.Args[1]
+		if z_1.Op != OpAMD64SHRBconst {
+			break
+		}
+		s1 := z_1.AuxInt
+		if x != z_1.Args[0] {
+			break
+		}
+		v_0_1 := v_0.Args[1]
+		if v_0_1.Op != OpAMD64SHRBconst {
+			break
+		}
+		s3 := v_0_1.AuxInt
+		y2 := v_0_1.Args[0]
+		if !(y == y2 && z == z2 && isByteParityFold(s1, s2, s3)) {
+			break
+		}
+		v.reset(OpAMD64MOVBQZX)
+		v0 := b.NewValue0(v.Pos, OpAMD64SETORD, typ.UInt8)
+		v1 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+		v1.AddArg(x)
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (ANDLconst [1] (XORL y:(XORL (SHRBconst [s2] z2) z:(XORL (SHRBconst [s1] x) x)) (SHRBconst [s3] y2)))
+	// cond: y == y2 && z == z2 && isByteParityFold(s1, s2, s3)
+	// result: (MOVBQZX (SETORD <typ.UInt8> (TESTB x x)))
+	for {
+		if v.AuxInt != 1 {
+			break
+		}
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64XORL {
+			break
+		}
+		_ = v_0.Args[1]
+		y := v_0.Args[0]
+		if y.Op != OpAMD64XORL {
+			break
+		}
+		_ = y.Args[1]
+		y_0 := y.Args[0]
+		if y_0.Op != OpAMD64SHRBconst {
+			break
+		}
+		s2 := y_0.AuxInt
+		z2 := y_0.Args[0]
+		z := y.Args[1]
+		if z.Op != OpAMD64XORL {
+			break
+		}
+		_ = z.Args[1]
+		z_0 := z.Args[0]
+		if z_0.Op != OpAMD64SHRBconst {
+			break
+		}
+		s1 := z_0.AuxInt
+		x := z_0.Args[0]
+		if x != z.Args[1] {
+			break
+		}
+		v_0_1 := v_0.Args[1]
+		if v_0_1.Op != OpAMD64SHRBconst {
+			break
+		}
+		s3 := v_0_1.AuxInt
+		y2 := v_0_1.Args[0]
+		if !(y == y2 && z == z2 && isByteParityFold(s1, s2, s3)) {
+			break
+		}
+		v.reset(OpAMD64MOVBQZX)
+		v0 := b.NewValue0(v.Pos, OpAMD64SETORD, typ.UInt8)
+		v1 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+		v1.AddArg(x)
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (ANDLconst [1] (XORL (SHRBconst [s3] y2) y:(XORL z:(XORL x (SHRBconst [s1] x)) (SHRBconst [s2] z2))))
+	// cond: y == y2 && z == z2 && isByteParityFold(s1, s2, s3)
+	// result: (MOVBQZX (SETORD <typ.UInt8> (TESTB x x)))
+	for {
+		if v.AuxInt != 1 {
+			break
+		}
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64XORL {
+			break
+		}
+		_ = v_0.Args[1]
+		v_0_0 := v_0.Args[0]
+		if v_0_0.Op != OpAMD64SHRBconst {
+			break
+		}
+		s3 := v_0_0.AuxInt
+		y2 := v_0_0.Args[0]
+		y := v_0.Args[1]
+		if y.Op != OpAMD64XORL {
+			break
+		}
+		_ = y.Args[1]
+		z := y.Args[0]
+		if z.Op != OpAMD64XORL {
+			break
+		}
+		_ = z.Args[1]
+		x := z.Args[0]
+		z_1 := z.Args[1]
+		if z_1.Op != OpAMD64SHRBconst {
+			break
+		}
+		s1 := z_1.AuxInt
+		if x != z_1.Args[0] {
+			break
+		}
+		y_1 := y.Args[1]
+		if y_1.Op != OpAMD64SHRBconst {
+			break
+		}
+		s2 := y_1.AuxInt
+		z2 := y_1.Args[0]
+		if !(y == y2 && z == z2 && isByteParityFold(s1, s2, s3)) {
+			break
+		}
+		v.reset(OpAMD64MOVBQZX)
+		v0 := b.NewValue0(v.Pos, OpAMD64SETORD, typ.UInt8)
+		v1 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+		v1.AddArg(x)
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (ANDLconst [1] (XORL (SHRBconst [s3] y2) y:(XORL z:(XORL (SHRBconst [s1] x) x) (SHRBconst [s2] z2))))
+	// cond: y == y2 && z == z2 && isByteParityFold(s1, s2, s3)
+	// result: (MOVBQZX (SETORD <typ.UInt8> (TESTB x x)))
+	for {
+		if v.AuxInt != 1 {
+			break
+		}
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64XORL {
+			break
+		}
+		_ = v_0.Args[1]
+		v_0_0 := v_0.Args[0]
+		if v_0_0.Op != OpAMD64SHRBconst {
+			break
+		}
+		s3 := v_0_0.AuxInt
+		y2 := v_0_0.Args[0]
+		y := v_0.Args[1]
+		if y.Op != OpAMD64XORL {
+			break
+		}
+		_ = y.Args[1]
+		z := y.Args[0]
+		if z.Op != OpAMD64XORL {
+			break
+		}
+		_ = z.Args[1]
+		z_0 := z.Args[0]
+		if z_0.Op != OpAMD64SHRBconst {
+			break
+		}
+		s1 := z_0.AuxInt
+		x := z_0.Args[0]
+		if x != z.Args[1] {
+			break
+		}
+		y_1 := y.Args[1]
+		if y_1.Op != OpAMD64SHRBconst {
+			break
+		}
+		s2 := y_1.AuxInt
+		z2 := y_1.Args[0]
+		if !(y == y2 && z == z2 && isByteParityFold(s1, s2, s3)) {
+			break
+		}
+		v.reset(OpAMD64MOVBQZX)
+		v0 := b.NewValue0(v.Pos, OpAMD64SETORD, typ.UInt8)
+		v1 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+		v1.AddArg(x)
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (ANDLconst [1] (XORL (SHRBconst [s3] y2) y:(XORL (SHRBconst [s2] z2) z:(XORL x (SHRBconst [s1] x)))))
+	// cond: y == y2 && z == z2 && isByteParityFold(s1, s2, s3)
+	// result: (MOVBQZX (SETORD <typ.UInt8> (TESTB x x)))
+	for {
+		if v.AuxInt != 1 {
+			break
+		}
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64XORL {
+			break
+		}
+		_ = v_0.Args[1]
+		v_0_0 := v_0.Args[0]
+		if v_0_0.Op != OpAMD64SHRBconst {
+			break
+		}
+		s3 := v_0_0.AuxInt
+		y2 := v_0_0.Args[0]
+		y := v_0.Args[1]
+		if y.Op != OpAMD64XORL {
+			break
+		}
+		_ = y.Args[1]
+		y_0 := y.Args[0]
+		if y_0.Op != OpAMD64SHRBconst {
+			break
+		}
+		s2 := y_0.AuxInt
+		z2 := y_0.Args[0]
+		z := y.Args[1]
+		if z.Op != OpAMD64XORL {
+			break
+		}
+		_ = z.Args[1]
+		x := z.Args[0]
+		z_1 := z.Args[1]
+		if z_1.Op != OpAMD64SHRBconst {
+			break
+		}
+		s1 := z_1.AuxInt
+		if x != z_1.Args[0] {
+			break
+		}
+		if !(y == y2 && z == z2 && isByteParityFold(s1, s2, s3)) {
+			break
+		}
+		v.reset(OpAMD64MOVBQZX)
+		v0 := b.NewValue0(v.Pos, OpAMD64SETORD, typ.UInt8)
+		v1 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+		v1.AddArg(x)
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+	return false
+}
+func rewriteValueAMD64_OpAMD64ANDLconst_30(v *Value) bool {
+	b := v.Block
+	_ = b
+	typ := &b.Func.Config.Types
+	_ = typ
+	// match: (ANDLconst [1] (XORL (SHRBconst [s3] y2) y:(XORL (SHRBconst [s2] z2) z:(XORL (SHRBconst [s1] x) x))))
+	// cond: y == y2 && z == z2 && isByteParityFold(s1, s2, s3)
+	// result: (MOVBQZX (SETORD <typ.UInt8> (TESTB x x)))
+	for {
+		if v.AuxInt != 1 {
+			break
+		}
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64XORL {
+			break
+		}
+		_ = v_0.Args[1]
+		v_0_0 := v_0.Args[0]
+		if v_0_0.Op != OpAMD64SHRBconst {
+			break
+		}
+		s3 := v_0_0.AuxInt
+		y2 := v_0_0.Args[0]
+		y := v_0.Args[1]
+		if y.Op != OpAMD64XORL {
+			break
+		}
+		_ = y.Args[1]
+		y_0 := y.Args[0]
+		if y_0.Op != OpAMD64SHRBconst {
+			break
+		}
+		s2 := y_0.AuxInt
+		z2 := y_0.Args[0]
+		z := y.Args[1]
+		if z.Op != OpAMD64XORL {
+			break
+		}
+		_ = z.Args[1]
+		z_0 := z.Args[0]
+		if z_0.Op != OpAMD64SHRBconst {
+			break
+		}
+		s1 := z_0.AuxInt
+		x := z_0.Args[0]
+		if x != z.Args[1] {
+			break
+		}
+		if !(y == y2 && z == z2 && isByteParityFold(s1, s2, s3)) {
+			break
+		}
+		v.reset(OpAMD64MOVBQZX)
+		v0 := b.NewValue0(v.Pos, OpAMD64SETORD, typ.UInt8)
+		v1 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+		v1.AddArg(x)
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
 	return false
 }
 func rewriteValueAMD64_OpAMD64ANDLmem_0(v *Value) bool {
@@ -3098,6 +4472,10 @@ func rewriteValueAMD64_OpAMD64ANDQ_0(v *Value) bool {
 	return false
 }
 func rewriteValueAMD64_OpAMD64ANDQconst_0(v *Value) bool {
+	b := v.Block
+	_ = b
+	typ := &b.Func.Config.Types
+	_ = typ
 	// match: (ANDQconst [c] (ANDQconst [d] x))
 	// cond:
 	// result: (ANDQconst [c & d] x)
@@ -3188,6 +4566,474 @@ func rewriteValueAMD64_OpAMD64ANDQconst_0(v *Value) bool {
 		v.AuxInt = c & d
 		return true
 	}
+	// match: (ANDQconst [1] (LoweredPopCount8 x))
+	// cond:
+	// result: (MOVBQZX (SETORD <typ.UInt8> (TESTB x x)))
+	for {
+		if v.AuxInt != 1 {
+			break
+		}
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64LoweredPopCount8 {
+			break
+		}
+		x := v_0.Args[0]
+		v.reset(OpAMD64MOVBQZX)
+		v0 := b.NewValue0(v.Pos, OpAMD64SETORD, typ.UInt8)
+		v1 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+		v1.AddArg(x)
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (ANDQconst [1] (XORQ y:(XORQ z:(XORQ x (SHRQconst [s1] x)) (SHRQconst [s2] z2)) (SHRQconst [s3] y2)))
+	// cond: y == y2 && z == z2 && isByteParityFold(s1, s2, s3)
+	// result: (MOVBQZX (SETORD <typ.UInt8> (TESTB x x)))
+	for {
+		if v.AuxInt != 1 {
+			break
+		}
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64XORQ {
+			break
+		}
+		_ = v_0.Args[1]
+		y := v_0.Args[0]
+		if y.Op != OpAMD64XORQ {
+			break
+		}
+		_ = y.Args[1]
+		z := y.Args[0]
+		if z.Op != OpAMD64XORQ {
+			break
+		}
+		_ = z.Args[1]
+		x := z.Args[0]
+		z_1 := z.Args[1]
+		if z_1.Op != OpAMD64SHRQconst {
+			break
+		}
+		s1 := z_1.AuxInt
+		if x != z_1.Args[0] {
+			break
+		}
+		y_1 := y.Args[1]
+		if y_1.Op != OpAMD64SHRQconst {
+			break
+		}
+		s2 := y_1.AuxInt
+		z2 := y_1.Args[0]
+		v_0_1 := v_0.Args[1]
+		if v_0_1.Op != OpAMD64SHRQconst {
+			break
+		}
+		s3 := v_0_1.AuxInt
+		y2 := v_0_1.Args[0]
+		if !(y == y2 && z == z2 && isByteParityFold(s1, s2, s3)) {
+			break
+		}
+		v.reset(OpAMD64MOVBQZX)
+		v0 := b.NewValue0(v.Pos, OpAMD64SETORD, typ.UInt8)
+		v1 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+		v1.AddArg(x)
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (ANDQconst [1] (XORQ y:(XORQ z:(XORQ (SHRQconst [s1] x) x) (SHRQconst [s2] z2)) (SHRQconst [s3] y2)))
+	// cond: y == y2 && z == z2 && isByteParityFold(s1, s2, s3)
+	// result: (MOVBQZX (SETORD <typ.UInt8> (TESTB x x)))
+	for {
+		if v.AuxInt != 1 {
+			break
+		}
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64XORQ {
+			break
+		}
+		_ = v_0.Args[1]
+		y := v_0.Args[0]
+		if y.Op != OpAMD64XORQ {
+			break
+		}
+		_ = y.Args[1]
+		z := y.Args[0]
+		if z.Op != OpAMD64XORQ {
+			break
+		}
+		_ = z.Args[1]
+		z_0 := z.Args[0]
+		if z_0.Op != OpAMD64SHRQconst {
+			break
+		}
+		s1 := z_0.AuxInt
+		x := z_0.Args[0]
+		if x != z.Args[1] {
+			break
+		}
+		y_1 := y.Args[1]
+		if y_1.Op != OpAMD64SHRQconst {
+			break
+		}
+		s2 := y_1.AuxInt
+		z2 := y_1.Args[0]
+		v_0_1 := v_0.Args[1]
+		if v_0_1.Op != OpAMD64SHRQconst {
+			break
+		}
+		s3 := v_0_1.AuxInt
+		y2 := v_0_1.Args[0]
+		if !(y == y2 && z == z2 && isByteParityFold(s1, s2, s3)) {
+			break
+		}
+		v.reset(OpAMD64MOVBQZX)
+		v0 := b.NewValue0(v.Pos, OpAMD64SETORD, typ.UInt8)
+		v1 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+		v1.AddArg(x)
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+	return false
+}
+func rewriteValueAMD64_OpAMD64ANDQconst_10(v *Value) bool {
+	b := v.Block
+	_ = b
+	typ := &b.Func.Config.Types
+	_ = typ
+	// match: (ANDQconst [1] (XORQ y:(XORQ (SHRQconst [s2] z2) z:(XORQ x (SHRQconst [s1] x))) (SHRQconst [s3] y2)))
+	// cond: y == y2 && z == z2 && isByteParityFold(s1, s2, s3)
+	// result: (MOVBQZX (SETORD <typ.UInt8> (TESTB x x)))
+	for {
+		if v.AuxInt != 1 {
+			break
+		}
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64XORQ {
+			break
+		}
+		_ = v_0.Args[1]
+		y := v_0.Args[0]
+		if y.Op != OpAMD64XORQ {
+			break
+		}
+		_ = y.Args[1]
+		y_0 := y.Args[0]
+		if y_0.Op != OpAMD64SHRQconst {
+			break
+		}
+		s2 := y_0.AuxInt
+		z2 := y_0.Args[0]
+		z := y.Args[1]
+		if z.Op != OpAMD64XORQ {
+			break
+		}
+		_ = z.Args[1]
+		x := z.Args[0]
+		z_1 := z.Args[1]
+		if z_1.Op != OpAMD64SHRQconst {
+			break
+		}
+		s1 := z_1.AuxInt
+		if x != z_1.Args[0] {
+			break
+		}
+		v_0_1 := v_0.Args[1]
+		if v_0_1.Op != OpAMD64SHRQconst {
+			break
+		}
+		s3 := v_0_1.AuxInt
+		y2 := v_0_1.Args[0]
+		if !(y == y2 && z == z2 && isByteParityFold(s1, s2, s3)) {
+			break
+		}
+		v.reset(OpAMD64MOVBQZX)
+		v0 := b.NewValue0(v.Pos, OpAMD64SETORD, typ.UInt8)
+		v1 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+		v1.AddArg(x)
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (ANDQconst [1] (XORQ y:(XORQ (SHRQconst [s2] z2) z:(XORQ (SHRQconst [s1] x) x)) (SHRQconst [s3] y2)))
+	// cond: y == y2 && z == z2 && isByteParityFold(s1, s2, s3)
+	// result: (MOVBQZX (SETORD <typ.UInt8> (TESTB x x)))
+	for {
+		if v.AuxInt != 1 {
+			break
+		}
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64XORQ {
+			break
+		}
+		_ = v_0.Args[1]
+		y := v_0.Args[0]
+		if y.Op != OpAMD64XORQ {
+			break
+		}
+		_ = y.Args[1]
+		y_0 := y.Args[0]
+		if y_0.Op != OpAMD64SHRQconst {
+			break
+		}
+		s2 := y_0.AuxInt
+		z2 := y_0.Args[0]
+		z := y.Args[1]
+		if z.Op != OpAMD64XORQ {
+			break
+		}
+		_ = z.Args[1]
+		z_0 := z.Args[0]
+		if z_0.Op != OpAMD64SHRQconst {
+			break
+		}
+		s1 := z_0.AuxInt
+		x := z_0.Args[0]
+		if x != z.Args[1] {
+			break
+		}
+		v_0_1 := v_0.Args[1]
+		if v_0_1.Op != OpAMD64SHRQconst {
+			break
+		}
+		s3 := v_0_1.AuxInt
+		y2 := v_0_1.Args[0]
+		if !(y == y2 && z == z2 && isByteParityFold(s1, s2, s3)) {
+			break
+		}
+		v.reset(OpAMD64MOVBQZX)
+		v0 := b.NewValue0(v.Pos, OpAMD64SETORD, typ.UInt8)
+		v1 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+		v1.AddArg(x)
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (ANDQconst [1] (XORQ (SHRQconst [s3] y2) y:(XORQ z:(XORQ x (SHRQconst [s1] x)) (SHRQconst [s2] z2))))
+	// cond: y == y2 && z == z2 && isByteParityFold(s1, s2, s3)
+	// result: (MOVBQZX (SETORD <typ.UInt8> (TESTB x x)))
+	for {
+		if v.AuxInt != 1 {
+			break
+		}
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64XORQ {
+			break
+		}
+		_ = v_0.Args[1]
+		v_0_0 := v_0.Args[0]
+		if v_0_0.Op != OpAMD64SHRQconst {
+			break
+		}
+		s3 := v_0_0.AuxInt
+		y2 := v_0_0.Args[0]
+		y := v_0.Args[1]
+		if y.Op != OpAMD64XORQ {
+			break
+		}
+		_ = y.Args[1]
+		z := y.Args[0]
+		if z.Op != OpAMD64XORQ {
+			break
+		}
+		_ = z.Args[1]
+		x := z.Args[0]
+		z_1 := z.Args[1]
+		if z_1.Op != OpAMD64SHRQconst {
+			break
+		}
+		s1 := z_1.AuxInt
+		if x != z_1.Args[0] {
+			break
+		}
+		y_1 := y.Args[1]
+		if y_1.Op != OpAMD64SHRQconst {
+			break
+		}
+		s2 := y_1.AuxInt
+		z2 := y_1.Args[0]
+		if !(y == y2 && z == z2 && isByteParityFold(s1, s2, s3)) {
+			break
+		}
+		v.reset(OpAMD64MOVBQZX)
+		v0 := b.NewValue0(v.Pos, OpAMD64SETORD, typ.UInt8)
+		v1 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+		v1.AddArg(x)
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (ANDQconst [1] (XORQ (SHRQconst [s3] y2) y:(XORQ z:(XORQ (SHRQconst [s1] x) x) (SHRQconst [s2] z2))))
+	// cond: y == y2 && z == z2 && isByteParityFold(s1, s2, s3)
+	// result: (MOVBQZX (SETORD <typ.UInt8> (TESTB x x)))
+	for {
+		if v.AuxInt != 1 {
+			break
+		}
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64XORQ {
+			break
+		}
+		_ = v_0.Args[1]
+		v_0_0 := v_0.Args[0]
+		if v_0_0.Op != OpAMD64SHRQconst {
+			break
+		}
+		s3 := v_0_0.AuxInt
+		y2 := v_0_0.Args[0]
+		y := v_0.Args[1]
+		if y.Op != OpAMD64XORQ {
+			break
+		}
+		_ = y.Args[1]
+		z := y.Args[0]
+		if z.Op != OpAMD64XORQ {
+			break
+		}
+		_ = z.Args[1]
+		z_0 := z.Args[0]
+		if z_0.Op != OpAMD64SHRQconst {
+			break
+		}
+		s1 := z_0.AuxInt
+		x := z_0.Args[0]
+		if x != z.Args[1] {
+			break
+		}
+		y_1 := y.Args[1]
+		if y_1.Op != OpAMD64SHRQconst {
+			break
+		}
+		s2 := y_1.AuxInt
+		z2 := y_1.Args[0]
+		if !(y == y2 && z == z2 && isByteParityFold(s1, s2, s3)) {
+			break
+		}
+		v.reset(OpAMD64MOVBQZX)
+		v0 := b.NewValue0(v.Pos, OpAMD64SETORD, typ.UInt8)
+		v1 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+		v1.AddArg(x)
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (ANDQconst [1] (XORQ (SHRQconst [s3] y2) y:(XORQ (SHRQconst [s2] z2) z:(XORQ x (SHRQconst [s1] x)))))
+	// cond: y == y2 && z == z2 && isByteParityFold(s1, s2, s3)
+	// result: (MOVBQZX (SETORD <typ.UInt8> (TESTB x x)))
+	for {
+		if v.AuxInt != 1 {
+			break
+		}
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64XORQ {
+			break
+		}
+		_ = v_0.Args[1]
+		v_0_0 := v_0.Args[0]
+		if v_0_0.Op != OpAMD64SHRQconst {
+			break
+		}
+		s3 := v_0_0.AuxInt
+		y2 := v_0_0.Args[0]
+		y := v_0.Args[1]
+		if y.Op != OpAMD64XORQ {
+			break
+		}
+		_ = y.Args[1]
+		y_0 := y.Args[0]
+		if y_0.Op != OpAMD64SHRQconst {
+			break
+		}
+		s2 := y_0.AuxInt
+		z2 := y_0.Args[0]
+		z := y.Args[1]
+		if z.Op != OpAMD64XORQ {
+			break
+		}
+		_ = z.Args[1]
+		x := z.Args[0]
+		z_1 := z.Args[1]
+		if z_1.Op != OpAMD64SHRQconst {
+			break
+		}
+		s1 := z_1.AuxInt
+		if x != z_1.Args[0] {
+			break
+		}
+		if !(y == y2 && z == z2 && isByteParityFold(s1, s2, s3)) {
+			break
+		}
+		v.reset(OpAMD64MOVBQZX)
+		v0 := b.NewValue0(v.Pos, OpAMD64SETORD, typ.UInt8)
+		v1 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+		v1.AddArg(x)
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (ANDQconst [1] (XORQ (SHRQconst [s3] y2) y:(XORQ (SHRQconst [s2] z2) z:(XORQ (SHRQconst [s1] x) x))))
+	// cond: y == y2 && z == z2 && isByteParityFold(s1, s2, s3)
+	// result: (MOVBQZX (SETORD <typ.UInt8> (TESTB x x)))
+	for {
+		if v.AuxInt != 1 {
+			break
+		}
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64XORQ {
+			break
+		}
+		_ = v_0.Args[1]
+		v_0_0 := v_0.Args[0]
+		if v_0_0.Op != OpAMD64SHRQconst {
+			break
+		}
+		s3 := v_0_0.AuxInt
+		y2 := v_0_0.Args[0]
+		y := v_0.Args[1]
+		if y.Op != OpAMD64XORQ {
+			break
+		}
+		_ = y.Args[1]
+		y_0 := y.Args[0]
+		if y_0.Op != OpAMD64SHRQconst {
+			break
+		}
+		s2 := y_0.AuxInt
+		z2 := y_0.Args[0]
+		z := y.Args[1]
+		if z.Op != OpAMD64XORQ {
+			break
+		}
+		_ = z.Args[1]
+		z_0 := z.Args[0]
+		if z_0.Op != OpAMD64SHRQconst {
+			break
+		}
+		s1 := z_0.AuxInt
+		x := z_0.Args[0]
+		if x != z.Args[1] {
+			break
+		}
+		if !(y == y2 && z == z2 && isByteParityFold(s1, s2, s3)) {
+			break
+		}
+		v.reset(OpAMD64MOVBQZX)
+		v0 := b.NewValue0(v.Pos, OpAMD64SETORD, typ.UInt8)
+		v1 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+		v1.AddArg(x)
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
 	return false
 }
 func rewriteValueAMD64_OpAMD64ANDQmem_0(v *Value) bool {
@@ -39896,7 +41742,7 @@ func rewriteValueAMD64_OpAMD64SETEQ_0(v *Value) bool {
 		return true
 	}
 	// match: (SETEQ (TESTLconst [c] x))
-	// cond: isUint32PowerOfTwo(c) && !config.nacl
+	// cond: isUint32PowerOfTwo(c) && log2uint32(c) >= 7 && !config.nacl
 	// result: (SETAE (BTLconst [log2uint32(c)] x))
 	for {
 		v_0 := v.Args[0]
@@ -39905,7 +41751,7 @@ func rewriteValueAMD64_OpAMD64SETEQ_0(v *Value) bool {
 		}
 		c := v_0.AuxInt
 		x := v_0.Args[0]
-		if !(isUint32PowerOfTwo(c) && !config.nacl) {
+		if !(isUint32PowerOfTwo(c) && log2uint32(c) >= 7 && !config.nacl) {
 			break
 		}
 		v.reset(OpAMD64SETAE)
@@ -39916,7 +41762,7 @@ func rewriteValueAMD64_OpAMD64SETEQ_0(v *Value) bool {
 		return true
 	}
 	// match: (SETEQ (TESTQconst [c] x))
-	// cond: isUint64PowerOfTwo(c) && !config.nacl
+	// cond: isUint64PowerOfTwo(c) && log2(c) >= 7 && !config.nacl
 	// result: (SETAE (BTQconst [log2(c)] x))
 	for {
 		v_0 := v.Args[0]
@@ -39925,7 +41771,7 @@ func rewriteValueAMD64_OpAMD64SETEQ_0(v *Value) bool {
 		}
 		c := v_0.AuxInt
 		x := v_0.Args[0]
-		if !(isUint64PowerOfTwo(c) && !config.nacl) {
+		if !(isUint64PowerOfTwo(c) && log2(c) >= 7 && !config.nacl) {
 			break
 		}
 		v.reset(OpAMD64SETAE)
@@ -39936,7 +41782,7 @@ func rewriteValueAMD64_OpAMD64SETEQ_0(v *Value) bool {
 		return true
 	}
 	// match: (SETEQ (TESTQ (MOVQconst [c]) x))
-	// cond: isUint64PowerOfTwo(c) && !config.nacl
+	// cond: isUint64PowerOfTwo(c) && log2(c) >= 7 && !config.nacl
 	// result: (SETAE (BTQconst [log2(c)] x))
 	for {
 		v_0 := v.Args[0]
@@ -39950,7 +41796,7 @@ func rewriteValueAMD64_OpAMD64SETEQ_0(v *Value) bool {
 		}
 		c := v_0_0.AuxInt
 		x := v_0.Args[1]
-		if !(isUint64PowerOfTwo(c) && !config.nacl) {
+		if !(isUint64PowerOfTwo(c) && log2(c) >= 7 && !config.nacl) {
 			break
 		}
 		v.reset(OpAMD64SETAE)
@@ -39961,7 +41807,7 @@ func rewriteValueAMD64_OpAMD64SETEQ_0(v *Value) bool {
 		return true
 	}
 	// match: (SETEQ (TESTQ x (MOVQconst [c])))
-	// cond: isUint64PowerOfTwo(c) && !config.nacl
+	// cond: isUint64PowerOfTwo(c) && log2(c) >= 7 && !config.nacl
 	// result: (SETAE (BTQconst [log2(c)] x))
 	for {
 		v_0 := v.Args[0]
@@ -39975,7 +41821,7 @@ func rewriteValueAMD64_OpAMD64SETEQ_0(v *Value) bool {
 			break
 		}
 		c := v_0_1.AuxInt
-		if !(isUint64PowerOfTwo(c) && !config.nacl) {
+		if !(isUint64PowerOfTwo(c) && log2(c) >= 7 && !config.nacl) {
 			break
 		}
 		v.reset(OpAMD64SETAE)
@@ -40013,6 +41859,8 @@ func rewriteValueAMD64_OpAMD64SETEQ_0(v *Value) bool {
 	return false
 }
 func rewriteValueAMD64_OpAMD64SETEQ_10(v *Value) bool {
+	b := v.Block
+	_ = b
 	// match: (SETEQ (FlagLT_ULT))
 	// cond:
 	// result: (MOVLconst [0])
@@ -40061,6 +41909,148 @@ func rewriteValueAMD64_OpAMD64SETEQ_10(v *Value) bool {
 		v.AuxInt = 0
 		return true
 	}
+	// match: (SETEQ (TESTQconst [1] (LoweredPopCount8 x)))
+	// cond:
+	// result: (SETNAN (TESTB x x))
+	for {
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64TESTQconst {
+			break
+		}
+		if v_0.AuxInt != 1 {
+			break
+		}
+		v_0_0 := v_0.Args[0]
+		if v_0_0.Op != OpAMD64LoweredPopCount8 {
+			break
+		}
+		x := v_0_0.Args[0]
+		v.reset(OpAMD64SETNAN)
+		v0 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+		v0.AddArg(x)
+		v0.AddArg(x)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (SETEQ (TESTLconst [1] (LoweredPopCount8 x)))
+	// cond:
+	// result: (SETNAN (TESTB x x))
+	for {
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64TESTLconst {
+			break
+		}
+		if v_0.AuxInt != 1 {
+			break
+		}
+		v_0_0 := v_0.Args[0]
+		if v_0_0.Op != OpAMD64LoweredPopCount8 {
+			break
+		}
+		x := v_0_0.Args[0]
+		v.reset(OpAMD64SETNAN)
+		v0 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+		v0.AddArg(x)
+		v0.AddArg(x)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (SETEQ (TESTWconst [1] (LoweredPopCount8 x)))
+	// cond:
+	// result: (SETNAN (TESTB x x))
+	for {
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64TESTWconst {
+			break
+		}
+		if v_0.AuxInt != 1 {
+			break
+		}
+		v_0_0 := v_0.Args[0]
+		if v_0_0.Op != OpAMD64LoweredPopCount8 {
+			break
+		}
+		x := v_0_0.Args[0]
+		v.reset(OpAMD64SETNAN)
+		v0 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+		v0.AddArg(x)
+		v0.AddArg(x)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (SETEQ (TESTBconst [1] (LoweredPopCount8 x)))
+	// cond:
+	// result: (SETNAN (TESTB x x))
+	for {
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64TESTBconst {
+			break
+		}
+		if v_0.AuxInt != 1 {
+			break
+		}
+		v_0_0 := v_0.Args[0]
+		if v_0_0.Op != OpAMD64LoweredPopCount8 {
+			break
+		}
+		x := v_0_0.Args[0]
+		v.reset(OpAMD64SETNAN)
+		v0 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+		v0.AddArg(x)
+		v0.AddArg(x)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (SETEQ (TESTB (SETORD f) (SETORD f)))
+	// cond:
+	// result: (SETNAN f)
+	for {
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64TESTB {
+			break
+		}
+		_ = v_0.Args[1]
+		v_0_0 := v_0.Args[0]
+		if v_0_0.Op != OpAMD64SETORD {
+			break
+		}
+		f := v_0_0.Args[0]
+		v_0_1 := v_0.Args[1]
+		if v_0_1.Op != OpAMD64SETORD {
+			break
+		}
+		if f != v_0_1.Args[0] {
+			break
+		}
+		v.reset(OpAMD64SETNAN)
+		v.AddArg(f)
+		return true
+	}
+	// match: (SETEQ (TESTB (SETORD f) (SETORD f)))
+	// cond:
+	// result: (SETNAN f)
+	for {
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64TESTB {
+			break
+		}
+		_ = v_0.Args[1]
+		v_0_0 := v_0.Args[0]
+		if v_0_0.Op != OpAMD64SETORD {
+			break
+		}
+		f := v_0_0.Args[0]
+		v_0_1 := v_0.Args[1]
+		if v_0_1.Op != OpAMD64SETORD {
+			break
+		}
+		if f != v_0_1.Args[0] {
+			break
+		}
+		v.reset(OpAMD64SETNAN)
+		v.AddArg(f)
+		return true
+	}
 	return false
 }
 func rewriteValueAMD64_OpAMD64SETEQmem_0(v *Value) bool {
@@ -40237,7 +42227,7 @@ func rewriteValueAMD64_OpAMD64SETEQmem_0(v *Value) bool {
 		return true
 	}
 	// match: (SETEQmem [off] {sym} ptr (TESTLconst [c] x) mem)
-	// cond: isUint32PowerOfTwo(c) && !config.nacl
+	// cond: isUint32PowerOfTwo(c) && log2uint32(c) >= 7 && !config.nacl
 	// result: (SETAEmem [off] {sym} ptr (BTLconst [log2uint32(c)] x) mem)
 	for {
 		off := v.AuxInt
@@ -40251,7 +42241,7 @@ func rewriteValueAMD64_OpAMD64SETEQmem_0(v *Value) bool {
 		c := v_1.AuxInt
 		x := v_1.Args[0]
 		mem := v.Args[2]
-		if !(isUint32PowerOfTwo(c) && !config.nacl) {
+		if !(isUint32PowerOfTwo(c) && log2uint32(c) >= 7 && !config.nacl) {
 			break
 		}
 		v.reset(OpAMD64SETAEmem)
@@ -40266,7 +42256,7 @@ func rewriteValueAMD64_OpAMD64SETEQmem_0(v *Value) bool {
 		return true
 	}
 	// match: (SETEQmem [off] {sym} ptr (TESTQconst [c] x) mem)
-	// cond: isUint64PowerOfTwo(c) && !config.nacl
+	// cond: isUint64PowerOfTwo(c) && log2(c) >= 7 && !config.nacl
 	// result: (SETAEmem [off] {sym} ptr (BTQconst [log2(c)] x) mem)
 	for {
 		off := v.AuxInt
@@ -40280,7 +42270,7 @@ func rewriteValueAMD64_OpAMD64SETEQmem_0(v *Value) bool {
 		c := v_1.AuxInt
 		x := v_1.Args[0]
 		mem := v.Args[2]
-		if !(isUint64PowerOfTwo(c) && !config.nacl) {
+		if !(isUint64PowerOfTwo(c) && log2(c) >= 7 && !config.nacl) {
 			break
 		}
 		v.reset(OpAMD64SETAEmem)
@@ -40295,7 +42285,7 @@ func rewriteValueAMD64_OpAMD64SETEQmem_0(v *Value) bool {
 		return true
 	}
 	// match: (SETEQmem [off] {sym} ptr (TESTQ (MOVQconst [c]) x) mem)
-	// cond: isUint64PowerOfTwo(c) && !config.nacl
+	// cond: isUint64PowerOfTwo(c) && log2(c) >= 7 && !config.nacl
 	// result: (SETAEmem [off] {sym} ptr (BTQconst [log2(c)] x) mem)
 	for {
 		off := v.AuxInt
@@ -40314,7 +42304,7 @@ func rewriteValueAMD64_OpAMD64SETEQmem_0(v *Value) bool {
 		c := v_1_0.AuxInt
 		x := v_1.Args[1]
 		mem := v.Args[2]
-		if !(isUint64PowerOfTwo(c) && !config.nacl) {
+		if !(isUint64PowerOfTwo(c) && log2(c) >= 7 && !config.nacl) {
 			break
 		}
 		v.reset(OpAMD64SETAEmem)
@@ -40329,7 +42319,7 @@ func rewriteValueAMD64_OpAMD64SETEQmem_0(v *Value) bool {
 		return true
 	}
 	// match: (SETEQmem [off] {sym} ptr (TESTQ x (MOVQconst [c])) mem)
-	// cond: isUint64PowerOfTwo(c) && !config.nacl
+	// cond: isUint64PowerOfTwo(c) && log2(c) >= 7 && !config.nacl
 	// result: (SETAEmem [off] {sym} ptr (BTQconst [log2(c)] x) mem)
 	for {
 		off := v.AuxInt
@@ -40348,7 +42338,7 @@ func rewriteValueAMD64_OpAMD64SETEQmem_0(v *Value) bool {
 		}
 		c := v_1_1.AuxInt
 		mem := v.Args[2]
-		if !(isUint64PowerOfTwo(c) && !config.nacl) {
+		if !(isUint64PowerOfTwo(c) && log2(c) >= 7 && !config.nacl) {
 			break
 		}
 		v.reset(OpAMD64SETAEmem)
@@ -40415,6 +42405,8 @@ func rewriteValueAMD64_OpAMD64SETEQmem_0(v *Value) bool {
 func rewriteValueAMD64_OpAMD64SETEQmem_10(v *Value) bool {
 	b := v.Block
 	_ = b
+	typ := &b.Func.Config.Types
+	_ = typ
 	// match: (SETEQmem [off1] {sym1} (LEAQ [off2] {sym2} base) val mem)
 	// cond: is32Bit(off1+off2) && canMergeSym(sym1, sym2)
 	// result: (SETEQmem [off1+off2] {mergeSym(sym1,sym2)} base val mem)
@@ -40557,6 +42549,78 @@ func rewriteValueAMD64_OpAMD64SETEQmem_10(v *Value) bool {
 		v.AddArg(mem)
 		return true
 	}
+	// match: (SETEQmem [off] {sym} ptr (TESTB (SETORD f) (SETORD f)) mem)
+	// cond:
+	// result: (MOVBstore [off] {sym} ptr (SETNAN <typ.UInt8> f) mem)
+	for {
+		off := v.AuxInt
+		sym := v.Aux
+		_ = v.Args[2]
+		ptr := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpAMD64TESTB {
+			break
+		}
+		_ = v_1.Args[1]
+		v_1_0 := v_1.Args[0]
+		if v_1_0.Op != OpAMD64SETORD {
+			break
+		}
+		f := v_1_0.Args[0]
+		v_1_1 := v_1.Args[1]
+		if v_1_1.Op != OpAMD64SETORD {
+			break
+		}
+		if f != v_1_1.Args[0] {
+			break
+		}
+		mem := v.Args[2]
+		v.reset(OpAMD64MOVBstore)
+		v.AuxInt = off
+		v.Aux = sym
+		v.AddArg(ptr)
+		v0 := b.NewValue0(v.Pos, OpAMD64SETNAN, typ.UInt8)
+		v0.AddArg(f)
+		v.AddArg(v0)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (SETEQmem [off] {sym} ptr (TESTB (SETORD f) (SETORD f)) mem)
+	// cond:
+	// result: (MOVBstore [off] {sym} ptr (SETNAN <typ.UInt8> f) mem)
+	for {
+		off := v.AuxInt
+		sym := v.Aux
+		_ = v.Args[2]
+		ptr := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpAMD64TESTB {
+			break
+		}
+		_ = v_1.Args[1]
+		v_1_0 := v_1.Args[0]
+		if v_1_0.Op != OpAMD64SETORD {
+			break
+		}
+		f := v_1_0.Args[0]
+		v_1_1 := v_1.Args[1]
+		if v_1_1.Op != OpAMD64SETORD {
+			break
+		}
+		if f != v_1_1.Args[0] {
+			break
+		}
+		mem := v.Args[2]
+		v.reset(OpAMD64MOVBstore)
+		v.AuxInt = off
+		v.Aux = sym
+		v.AddArg(ptr)
+		v0 := b.NewValue0(v.Pos, OpAMD64SETNAN, typ.UInt8)
+		v0.AddArg(f)
+		v.AddArg(v0)
+		v.AddArg(mem)
+		return true
+	}
 	return false
 }
 func rewriteValueAMD64_OpAMD64SETG_0(v *Value) bool {
@@ -41781,7 +43845,7 @@ func rewriteValueAMD64_OpAMD64SETNE_0(v *Value) bool {
 		return true
 	}
 	// match: (SETNE (TESTLconst [c] x))
-	// cond: isUint32PowerOfTwo(c) && !config.nacl
+	// cond: isUint32PowerOfTwo(c) && log2uint32(c) >= 7 && !config.nacl
 	// result: (SETB (BTLconst [log2uint32(c)] x))
 	for {
 		v_0 := v.Args[0]
@@ -41790,7 +43854,7 @@ func rewriteValueAMD64_OpAMD64SETNE_0(v *Value) bool {
 		}
 		c := v_0.AuxInt
 		x := v_0.Args[0]
-		if !(isUint32PowerOfTwo(c) && !config.nacl) {
+		if !(isUint32PowerOfTwo(c) && log2uint32(c) >= 7 && !config.nacl) {
 			break
 		}
 		v.reset(OpAMD64SETB)
@@ -41801,7 +43865,7 @@ func rewriteValueAMD64_OpAMD64SETNE_0(v *Value) bool {
 		return true
 	}
 	// match: (SETNE (TESTQconst [c] x))
-	// cond: isUint64PowerOfTwo(c) && !config.nacl
+	// cond: isUint64PowerOfTwo(c) && log2(c) >= 7 && !config.nacl
 	// result: (SETB (BTQconst [log2(c)] x))
 	for {
 		v_0 := v.Args[0]
@@ -41810,7 +43874,7 @@ func rewriteValueAMD64_OpAMD64SETNE_0(v *Value) bool {
 		}
 		c := v_0.AuxInt
 		x := v_0.Args[0]
-		if !(isUint64PowerOfTwo(c) && !config.nacl) {
+		if !(isUint64PowerOfTwo(c) && log2(c) >= 7 && !config.nacl) {
 			break
 		}
 		v.reset(OpAMD64SETB)
@@ -41821,7 +43885,7 @@ func rewriteValueAMD64_OpAMD64SETNE_0(v *Value) bool {
 		return true
 	}
 	// match: (SETNE (TESTQ (MOVQconst [c]) x))
-	// cond: isUint64PowerOfTwo(c) && !config.nacl
+	// cond: isUint64PowerOfTwo(c) && log2(c) >= 7 && !config.nacl
 	// result: (SETB (BTQconst [log2(c)] x))
 	for {
 		v_0 := v.Args[0]
@@ -41835,7 +43899,7 @@ func rewriteValueAMD64_OpAMD64SETNE_0(v *Value) bool {
 		}
 		c := v_0_0.AuxInt
 		x := v_0.Args[1]
-		if !(isUint64PowerOfTwo(c) && !config.nacl) {
+		if !(isUint64PowerOfTwo(c) && log2(c) >= 7 && !config.nacl) {
 			break
 		}
 		v.reset(OpAMD64SETB)
@@ -41846,7 +43910,7 @@ func rewriteValueAMD64_OpAMD64SETNE_0(v *Value) bool {
 		return true
 	}
 	// match: (SETNE (TESTQ x (MOVQconst [c])))
-	// cond: isUint64PowerOfTwo(c) && !config.nacl
+	// cond: isUint64PowerOfTwo(c) && log2(c) >= 7 && !config.nacl
 	// result: (SETB (BTQconst [log2(c)] x))
 	for {
 		v_0 := v.Args[0]
@@ -41860,7 +43924,7 @@ func rewriteValueAMD64_OpAMD64SETNE_0(v *Value) bool {
 			break
 		}
 		c := v_0_1.AuxInt
-		if !(isUint64PowerOfTwo(c) && !config.nacl) {
+		if !(isUint64PowerOfTwo(c) && log2(c) >= 7 && !config.nacl) {
 			break
 		}
 		v.reset(OpAMD64SETB)
@@ -41898,6 +43962,8 @@ func rewriteValueAMD64_OpAMD64SETNE_0(v *Value) bool {
 	return false
 }
 func rewriteValueAMD64_OpAMD64SETNE_10(v *Value) bool {
+	b := v.Block
+	_ = b
 	// match: (SETNE (FlagLT_ULT))
 	// cond:
 	// result: (MOVLconst [1])
@@ -41946,6 +44012,148 @@ func rewriteValueAMD64_OpAMD64SETNE_10(v *Value) bool {
 		v.AuxInt = 1
 		return true
 	}
+	// match: (SETNE (TESTQconst [1] (LoweredPopCount8 x)))
+	// cond:
+	// result: (SETORD (TESTB x x))
+	for {
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64TESTQconst {
+			break
+		}
+		if v_0.AuxInt != 1 {
+			break
+		}
+		v_0_0 := v_0.Args[0]
+		if v_0_0.Op != OpAMD64LoweredPopCount8 {
+			break
+		}
+		x := v_0_0.Args[0]
+		v.reset(OpAMD64SETORD)
+		v0 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+		v0.AddArg(x)
+		v0.AddArg(x)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (SETNE (TESTLconst [1] (LoweredPopCount8 x)))
+	// cond:
+	// result: (SETORD (TESTB x x))
+	for {
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64TESTLconst {
+			break
+		}
+		if v_0.AuxInt != 1 {
+			break
+		}
+		v_0_0 := v_0.Args[0]
+		if v_0_0.Op != OpAMD64LoweredPopCount8 {
+			break
+		}
+		x := v_0_0.Args[0]
+		v.reset(OpAMD64SETORD)
+		v0 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+		v0.AddArg(x)
+		v0.AddArg(x)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (SETNE (TESTWconst [1] (LoweredPopCount8 x)))
+	// cond:
+	// result: (SETORD (TESTB x x))
+	for {
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64TESTWconst {
+			break
+		}
+		if v_0.AuxInt != 1 {
+			break
+		}
+		v_0_0 := v_0.Args[0]
+		if v_0_0.Op != OpAMD64LoweredPopCount8 {
+			break
+		}
+		x := v_0_0.Args[0]
+		v.reset(OpAMD64SETORD)
+		v0 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+		v0.AddArg(x)
+		v0.AddArg(x)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (SETNE (TESTBconst [1] (LoweredPopCount8 x)))
+	// cond:
+	// result: (SETORD (TESTB x x))
+	for {
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64TESTBconst {
+			break
+		}
+		if v_0.AuxInt != 1 {
+			break
+		}
+		v_0_0 := v_0.Args[0]
+		if v_0_0.Op != OpAMD64LoweredPopCount8 {
+			break
+		}
+		x := v_0_0.Args[0]
+		v.reset(OpAMD64SETORD)
+		v0 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+		v0.AddArg(x)
+		v0.AddArg(x)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (SETNE (TESTB (SETORD f) (SETORD f)))
+	// cond:
+	// result: (SETORD f)
+	for {
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64TESTB {
+			break
+		}
+		_ = v_0.Args[1]
+		v_0_0 := v_0.Args[0]
+		if v_0_0.Op != OpAMD64SETORD {
+			break
+		}
+		f := v_0_0.Args[0]
+		v_0_1 := v_0.Args[1]
+		if v_0_1.Op != OpAMD64SETORD {
+			break
+		}
+		if f != v_0_1.Args[0] {
+			break
+		}
+		v.reset(OpAMD64SETORD)
+		v.AddArg(f)
+		return true
+	}
+	// match: (SETNE (TESTB (SETORD f) (SETORD f)))
+	// cond:
+	// result: (SETORD f)
+	for {
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64TESTB {
+			break
+		}
+		_ = v_0.Args[1]
+		v_0_0 := v_0.Args[0]
+		if v_0_0.Op != OpAMD64SETORD {
+			break
+		}
+		f := v_0_0.Args[0]
+		v_0_1 := v_0.Args[1]
+		if v_0_1.Op != OpAMD64SETORD {
+			break
+		}
+		if f != v_0_1.Args[0] {
+			break
+		}
+		v.reset(OpAMD64SETORD)
+		v.AddArg(f)
+		return true
+	}
 	return false
 }
 func rewriteValueAMD64_OpAMD64SETNEmem_0(v *Value) bool {
@@ -42122,7 +44330,7 @@ func rewriteValueAMD64_OpAMD64SETNEmem_0(v *Value) bool {
 		return true
 	}
 	// match: (SETNEmem [off] {sym} ptr (TESTLconst [c] x) mem)
-	// cond: isUint32PowerOfTwo(c) && !config.nacl
+	// cond: isUint32PowerOfTwo(c) && log2uint32(c) >= 7 && !config.nacl
 	// result: (SETBmem [off] {sym} ptr (BTLconst [log2uint32(c)] x) mem)
 	for {
 		off := v.AuxInt
@@ -42136,7 +44344,7 @@ func rewriteValueAMD64_OpAMD64SETNEmem_0(v *Value) bool {
 		c := v_1.AuxInt
 		x := v_1.Args[0]
 		mem := v.Args[2]
-		if !(isUint32PowerOfTwo(c) && !config.nacl) {
+		if !(isUint32PowerOfTwo(c) && log2uint32(c) >= 7 && !config.nacl) {
 			break
 		}
 		v.reset(OpAMD64SETBmem)
@@ -42151,7 +44359,7 @@ func rewriteValueAMD64_OpAMD64SETNEmem_0(v *Value) bool {
 		return true
 	}
 	// match: (SETNEmem [off] {sym} ptr (TESTQconst [c] x) mem)
-	// cond: isUint64PowerOfTwo(c) && !config.nacl
+	// cond: isUint64PowerOfTwo(c) && log2(c) >= 7 && !config.nacl
 	// result: (SETBmem [off] {sym} ptr (BTQconst [log2(c)] x) mem)
 	for {
 		off := v.AuxInt
@@ -42165,7 +44373,7 @@ func rewriteValueAMD64_OpAMD64SETNEmem_0(v *Value) bool {
 		c := v_1.AuxInt
 		x := v_1.Args[0]
 		mem := v.Args[2]
-		if !(isUint64PowerOfTwo(c) && !config.nacl) {
+		if !(isUint64PowerOfTwo(c) && log2(c) >= 7 && !config.nacl) {
 			break
 		}
 		v.reset(OpAMD64SETBmem)
@@ -42180,7 +44388,7 @@ func rewriteValueAMD64_OpAMD64SETNEmem_0(v *Value) bool {
 		return true
 	}
 	// match: (SETNEmem [off] {sym} ptr (TESTQ (MOVQconst [c]) x) mem)
-	// cond: isUint64PowerOfTwo(c) && !config.nacl
+	// cond: isUint64PowerOfTwo(c) && log2(c) >= 7 && !config.nacl
 	// result: (SETBmem [off] {sym} ptr (BTQconst [log2(c)] x) mem)
 	for {
 		off := v.AuxInt
@@ -42199,7 +44407,7 @@ func rewriteValueAMD64_OpAMD64SETNEmem_0(v *Value) bool {
 		c := v_1_0.AuxInt
 		x := v_1.Args[1]
 		mem := v.Args[2]
-		if !(isUint64PowerOfTwo(c) && !config.nacl) {
+		if !(isUint64PowerOfTwo(c) && log2(c) >= 7 && !config.nacl) {
 			break
 		}
 		v.reset(OpAMD64SETBmem)
@@ -42214,7 +44422,7 @@ func rewriteValueAMD64_OpAMD64SETNEmem_0(v *Value) bool {
 		return true
 	}
 	// match: (SETNEmem [off] {sym} ptr (TESTQ x (MOVQconst [c])) mem)
-	// cond: isUint64PowerOfTwo(c) && !config.nacl
+	// cond: isUint64PowerOfTwo(c) && log2(c) >= 7 && !config.nacl
 	// result: (SETBmem [off] {sym} ptr (BTQconst [log2(c)] x) mem)
 	for {
 		off := v.AuxInt
@@ -42233,7 +44441,7 @@ func rewriteValueAMD64_OpAMD64SETNEmem_0(v *Value) bool {
 		}
 		c := v_1_1.AuxInt
 		mem := v.Args[2]
-		if !(isUint64PowerOfTwo(c) && !config.nacl) {
+		if !(isUint64PowerOfTwo(c) && log2(c) >= 7 && !config.nacl) {
 			break
 		}
 		v.reset(OpAMD64SETBmem)
@@ -42300,6 +44508,8 @@ func rewriteValueAMD64_OpAMD64SETNEmem_0(v *Value) bool {
 func rewriteValueAMD64_OpAMD64SETNEmem_10(v *Value) bool {
 	b := v.Block
 	_ = b
+	typ := &b.Func.Config.Types
+	_ = typ
 	// match: (SETNEmem [off1] {sym1} (LEAQ [off2] {sym2} base) val mem)
 	// cond: is32Bit(off1+off2) && canMergeSym(sym1, sym2)
 	// result: (SETNEmem [off1+off2] {mergeSym(sym1,sym2)} base val mem)
@@ -42442,6 +44652,78 @@ func rewriteValueAMD64_OpAMD64SETNEmem_10(v *Value) bool {
 		v.AddArg(mem)
 		return true
 	}
+	// match: (SETNEmem [off] {sym} ptr (TESTB (SETORD f) (SETORD f)) mem)
+	// cond:
+	// result: (MOVBstore [off] {sym} ptr (SETORD <typ.UInt8> f) mem)
+	for {
+		off := v.AuxInt
+		sym := v.Aux
+		_ = v.Args[2]
+		ptr := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpAMD64TESTB {
+			break
+		}
+		_ = v_1.Args[1]
+		v_1_0 := v_1.Args[0]
+		if v_1_0.Op != OpAMD64SETORD {
+			break
+		}
+		f := v_1_0.Args[0]
+		v_1_1 := v_1.Args[1]
+		if v_1_1.Op != OpAMD64SETORD {
+			break
+		}
+		if f != v_1_1.Args[0] {
+			break
+		}
+		mem := v.Args[2]
+		v.reset(OpAMD64MOVBstore)
+		v.AuxInt = off
+		v.Aux = sym
+		v.AddArg(ptr)
+		v0 := b.NewValue0(v.Pos, OpAMD64SETORD, typ.UInt8)
+		v0.AddArg(f)
+		v.AddArg(v0)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (SETNEmem [off] {sym} ptr (TESTB (SETORD f) (SETORD f)) mem)
+	// cond:
+	// result: (MOVBstore [off] {sym} ptr (SETORD <typ.UInt8> f) mem)
+	for {
+		off := v.AuxInt
+		sym := v.Aux
+		_ = v.Args[2]
+		ptr := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpAMD64TESTB {
+			break
+		}
+		_ = v_1.Args[1]
+		v_1_0 := v_1.Args[0]
+		if v_1_0.Op != OpAMD64SETORD {
+			break
+		}
+		f := v_1_0.Args[0]
+		v_1_1 := v_1.Args[1]
+		if v_1_1.Op != OpAMD64SETORD {
+			break
+		}
+		if f != v_1_1.Args[0] {
+			break
+		}
+		mem := v.Args[2]
+		v.reset(OpAMD64MOVBstore)
+		v.AuxInt = off
+		v.Aux = sym
+		v.AddArg(ptr)
+		v0 := b.NewValue0(v.Pos, OpAMD64SETORD, typ.UInt8)
+		v0.AddArg(f)
+		v.AddArg(v0)
+		v.AddArg(mem)
+		return true
+	}
 	return false
 }
 func rewriteValueAMD64_OpAMD64SHLL_0(v *Value) bool {
@@ -44355,6 +46637,94 @@ func rewriteValueAMD64_OpAMD64TESTB_0(v *Value) bool {
 		v0.AddArg(mem)
 		return true
 	}
+	// match: (TESTB z:(MOVBQZX s:(SETORD _)) z2)
+	// cond: z == z2
+	// result: (TESTB s s)
+	for {
+		_ = v.Args[1]
+		z := v.Args[0]
+		if z.Op != OpAMD64MOVBQZX {
+			break
+		}
+		s := z.Args[0]
+		if s.Op != OpAMD64SETORD {
+			break
+		}
+		z2 := v.Args[1]
+		if !(z == z2) {
+			break
+		}
+		v.reset(OpAMD64TESTB)
+		v.AddArg(s)
+		v.AddArg(s)
+		return true
+	}
+	// match: (TESTB z2 z:(MOVBQZX s:(SETORD _)))
+	// cond: z == z2
+	// result: (TESTB s s)
+	for {
+		_ = v.Args[1]
+		z2 := v.Args[0]
+		z := v.Args[1]
+		if z.Op != OpAMD64MOVBQZX {
+			break
+		}
+		s := z.Args[0]
+		if s.Op != OpAMD64SETORD {
+			break
+		}
+		if !(z == z2) {
+			break
+		}
+		v.reset(OpAMD64TESTB)
+		v.AddArg(s)
+		v.AddArg(s)
+		return true
+	}
+	// match: (TESTB (MOVBQZX x) (MOVBQZX x))
+	// cond:
+	// result: (TESTB x x)
+	for {
+		_ = v.Args[1]
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64MOVBQZX {
+			break
+		}
+		x := v_0.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpAMD64MOVBQZX {
+			break
+		}
+		if x != v_1.Args[0] {
+			break
+		}
+		v.reset(OpAMD64TESTB)
+		v.AddArg(x)
+		v.AddArg(x)
+		return true
+	}
+	// match: (TESTB (MOVBQZX x) (MOVBQZX x))
+	// cond:
+	// result: (TESTB x x)
+	for {
+		_ = v.Args[1]
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64MOVBQZX {
+			break
+		}
+		x := v_0.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpAMD64MOVBQZX {
+			break
+		}
+		if x != v_1.Args[0] {
+			break
+		}
+		v.reset(OpAMD64TESTB)
+		v.AddArg(x)
+		v.AddArg(x)
+		return true
+	}
 	return false
 }
 func rewriteValueAMD64_OpAMD64TESTBconst_0(v *Value) bool {
@@ -44464,6 +46834,50 @@ func rewriteValueAMD64_OpAMD64TESTL_0(v *Value) bool {
 		v0.AddArg(mem)
 		return true
 	}
+	// match: (TESTL z:(MOVBQZX s:(SETORD _)) z2)
+	// cond: z == z2
+	// result: (TESTB s s)
+	for {
+		_ = v.Args[1]
+		z := v.Args[0]
+		if z.Op != OpAMD64MOVBQZX {
+			break
+		}
+		s := z.Args[0]
+		if s.Op != OpAMD64SETORD {
+			break
+		}
+		z2 := v.Args[1]
+		if !(z == z2) {
+			break
+		}
+		v.reset(OpAMD64TESTB)
+		v.AddArg(s)
+		v.AddArg(s)
+		return true
+	}
+	// match: (TESTL z2 z:(MOVBQZX s:(SETORD _)))
+	// cond: z == z2
+	// result: (TESTB s s)
+	for {
+		_ = v.Args[1]
+		z2 := v.Args[0]
+		z := v.Args[1]
+		if z.Op != OpAMD64MOVBQZX {
+			break
+		}
+		s := z.Args[0]
+		if s.Op != OpAMD64SETORD {
+			break
+		}
+		if !(z == z2) {
+			break
+		}
+		v.reset(OpAMD64TESTB)
+		v.AddArg(s)
+		v.AddArg(s)
+		return true
+	}
 	return false
 }
 func rewriteValueAMD64_OpAMD64TESTLconst_0(v *Value) bool {
@@ -44480,6 +46894,20 @@ func rewriteValueAMD64_OpAMD64TESTLconst_0(v *Value) bool {
 		v.AddArg(x)
 		return true
 	}
+	// match: (TESTLconst [c] x)
+	// cond: 0 <= c && c < 128
+	// result: (TESTBconst [c] x)
+	for {
+		c := v.AuxInt
+		x := v.Args[0]
+		if !(0 <= c && c < 128) {
+			break
+		}
+		v.reset(OpAMD64TESTBconst)
+		v.AuxInt = c
+		v.AddArg(x)
+		return true
+	}
 	return false
 }
 func rewriteValueAMD64_OpAMD64TESTQ_0(v *Value) bool {
@@ -44579,6 +47007,50 @@ func rewriteValueAMD64_OpAMD64TESTQ_0(v *Value) bool {
 		v0.AddArg(mem)
 		return true
 	}
+	// match: (TESTQ z:(MOVBQZX s:(SETORD _)) z2)
+	// cond: z == z2
+	// result: (TESTB s s)
+	for {
+		_ = v.Args[1]
+		z := v.Args[0]
+		if z.Op != OpAMD64MOVBQZX {
+			break
+		}
+		s := z.Args[0]
+		if s.Op != OpAMD64SETORD {
+			break
+		}
+		z2 := v.Args[1]
+		if !(z == z2) {
+			break
+		}
+		v.reset(OpAMD64TESTB)
+		v.AddArg(s)
+		v.AddArg(s)
+		return true
+	}
+	// match: (TESTQ z2 z:(MOVBQZX s:(SETORD _)))
+	// cond: z == z2
+	// result: (TESTB s s)
+	for {
+		_ = v.Args[1]
+		z2 := v.Args[0]
+		z := v.Args[1]
+		if z.Op != OpAMD64MOVBQZX {
+			break
+		}
+		s := z.Args[0]
+		if s.Op != OpAMD64SETORD {
+			break
+		}
+		if !(z == z2) {
+			break
+		}
+		v.reset(OpAMD64TESTB)
+		v.AddArg(s)
+		v.AddArg(s)
+		return true
+	}
 	return false
 }
 func rewriteValueAMD64_OpAMD64TESTQconst_0(v *Value) bool {
@@ -44595,6 +47067,20 @@ func rewriteValueAMD64_OpAMD64TESTQconst_0(v *Value) bool {
 		v.AddArg(x)
 		return true
 	}
+	// match: (TESTQconst [c] x)
+	// cond: 0 <= c && c < 128
+	// result: (TESTBconst [c] x)
+	for {
+		c := v.AuxInt
+		x := v.Args[0]
+		if !(0 <= c && c < 128) {
+			break
+		}
+		v.reset(OpAMD64TESTBconst)
+		v.AuxInt = c
+		v.AddArg(x)
+		return true
+	}
 	return false
 }
 func rewriteValueAMD64_OpAMD64TESTW_0(v *Value) bool {
@@ -44688,6 +47174,50 @@ func rewriteValueAMD64_OpAMD64TESTW_0(v *Value) bool {
 		v0.AddArg(mem)
 		return true
 	}
+	// match: (TESTW z:(MOVBQZX s:(SETORD _)) z2)
+	// cond: z == z2
+	// result: (TESTB s s)
+	for {
+		_ = v.Args[1]
+		z := v.Args[0]
+		if z.Op != OpAMD64MOVBQZX {
+			break
+		}
+		s := z.Args[0]
+		if s.Op != OpAMD64SETORD {
+			break
+		}
+		z2 := v.Args[1]
+		if !(z == z2) {
+			break
+		}
+		v.reset(OpAMD64TESTB)
+		v.AddArg(s)
+		v.AddArg(s)
+		return true
+	}
+	// match: (TESTW z2 z:(MOVBQZX s:(SETORD _)))
+	// cond: z == z2
+	// result: (TESTB s s)
+	for {
+		_ = v.Args[1]
+		z2 := v.Args[0]
+		z := v.Args[1]
+		if z.Op != OpAMD64MOVBQZX {
+			break
+		}
+		s := z.Args[0]
+		if s.Op != OpAMD64SETORD {
+			break
+		}
+		if !(z == z2) {
+			break
+		}
+		v.reset(OpAMD64TESTB)
+		v.AddArg(s)
+		v.AddArg(s)
+		return true
+	}
 	return false
 }
 func rewriteValueAMD64_OpAMD64TESTWconst_0(v *Value) bool {
@@ -44704,6 +47234,20 @@ func rewriteValueAMD64_OpAMD64TESTWconst_0(v *Value) bool {
 		v.AddArg(x)
 		return true
 	}
+	// match: (TESTWconst [c] x)
+	// cond: 0 <= c && c < 128
+	// result: (TESTBconst [c] x)
+	for {
+		c := v.AuxInt
+		x := v.Args[0]
+		if !(0 <= c && c < 128) {
+			break
+		}
+		v.reset(OpAMD64TESTBconst)
+		v.AuxInt = c
+		v.AddArg(x)
+		return true
+	}
 	return false
 }
 func rewriteValueAMD64_OpAMD64XADDLlock_0(v *Value) bool {
@@ -47370,6 +49914,265 @@ func rewriteValueAMD64_OpCondSelect_40(v *Value) bool {
 		v.AddArg(cond)
 		return true
 	}
+	// match: (CondSelect <t> x y (SETGF (UCOMISD x y)))
+	// cond: is64BitFloat(t)
+	// result: (MAXSD x y)
+	for {
+		t := v.Type
+		_ = v.Args[2]
+		x := v.Args[0]
+		y := v.Args[1]
+		v_2 := v.Args[2]
+		if v_2.Op != OpAMD64SETGF {
+			break
+		}
+		v_2_0 := v_2.Args[0]
+		if v_2_0.Op != OpAMD64UCOMISD {
+			break
+		}
+		_ = v_2_0.Args[1]
+		if x != v_2_0.Args[0] {
+			break
+		}
+		if y != v_2_0.Args[1] {
+			break
+		}
+		if !(is64BitFloat(t)) {
+			break
+		}
+		v.reset(OpAMD64MAXSD)
+		v.AddArg(x)
+		v.AddArg(y)
+		return true
+	}
+	// match: (CondSelect <t> x y (SETGF (UCOMISD y x)))
+	// cond: is64BitFloat(t)
+	// result: (MINSD x y)
+	for {
+		t := v.Type
+		_ = v.Args[2]
+		x := v.Args[0]
+		y := v.Args[1]
+		v_2 := v.Args[2]
+		if v_2.Op != OpAMD64SETGF {
+			break
+		}
+		v_2_0 := v_2.Args[0]
+		if v_2_0.Op != OpAMD64UCOMISD {
+			break
+		}
+		_ = v_2_0.Args[1]
+		if y != v_2_0.Args[0] {
+			break
+		}
+		if x != v_2_0.Args[1] {
+			break
+		}
+		if !(is64BitFloat(t)) {
+			break
+		}
+		v.reset(OpAMD64MINSD)
+		v.AddArg(x)
+		v.AddArg(y)
+		return true
+	}
+	// match: (CondSelect <t> x y (SETGF (UCOMISS x y)))
+	// cond: is32BitFloat(t)
+	// result: (MAXSS x y)
+	for {
+		t := v.Type
+		_ = v.Args[2]
+		x := v.Args[0]
+		y := v.Args[1]
+		v_2 := v.Args[2]
+		if v_2.Op != OpAMD64SETGF {
+			break
+		}
+		v_2_0 := v_2.Args[0]
+		if v_2_0.Op != OpAMD64UCOMISS {
+			break
+		}
+		_ = v_2_0.Args[1]
+		if x != v_2_0.Args[0] {
+			break
+		}
+		if y != v_2_0.Args[1] {
+			break
+		}
+		if !(is32BitFloat(t)) {
+			break
+		}
+		v.reset(OpAMD64MAXSS)
+		v.AddArg(x)
+		v.AddArg(y)
+		return true
+	}
+	// match: (CondSelect <t> x y (SETGF (UCOMISS y x)))
+	// cond: is32BitFloat(t)
+	// result: (MINSS x y)
+	for {
+		t := v.Type
+		_ = v.Args[2]
+		x := v.Args[0]
+		y := v.Args[1]
+		v_2 := v.Args[2]
+		if v_2.Op != OpAMD64SETGF {
+			break
+		}
+		v_2_0 := v_2.Args[0]
+		if v_2_0.Op != OpAMD64UCOMISS {
+			break
+		}
+		_ = v_2_0.Args[1]
+		if y != v_2_0.Args[0] {
+			break
+		}
+		if x != v_2_0.Args[1] {
+			break
+		}
+		if !(is32BitFloat(t)) {
+			break
+		}
+		v.reset(OpAMD64MINSS)
+		v.AddArg(x)
+		v.AddArg(y)
+		return true
+	}
+	// match: (CondSelect <t> (Const64F [x]) (Const64F [y]) check)
+	// cond: is64BitFloat(t)
+	// result: (MOVQi2f (CondSelect <typ.UInt64> (MOVQconst [x]) (MOVQconst [y]) check))
+	for {
+		t := v.Type
+		_ = v.Args[2]
+		v_0 := v.Args[0]
+		if v_0.Op != OpConst64F {
+			break
+		}
+		x := v_0.AuxInt
+		v_1 := v.Args[1]
+		if v_1.Op != OpConst64F {
+			break
+		}
+		y := v_1.AuxInt
+		check := v.Args[2]
+		if !(is64BitFloat(t)) {
+			break
+		}
+		v.reset(OpAMD64MOVQi2f)
+		v0 := b.NewValue0(v.Pos, OpCondSelect, typ.UInt64)
+		v1 := b.NewValue0(v.Pos, OpAMD64MOVQconst, typ.UInt64)
+		v1.AuxInt = x
+		v0.AddArg(v1)
+		v2 := b.NewValue0(v.Pos, OpAMD64MOVQconst, typ.UInt64)
+		v2.AuxInt = y
+		v0.AddArg(v2)
+		v0.AddArg(check)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (CondSelect <t> (MOVSDconst [x]) (MOVSDconst [y]) check)
+	// cond: is64BitFloat(t)
+	// result: (MOVQi2f (CondSelect <typ.UInt64> (MOVQconst [x]) (MOVQconst [y]) check))
+	for {
+		t := v.Type
+		_ = v.Args[2]
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64MOVSDconst {
+			break
+		}
+		x := v_0.AuxInt
+		v_1 := v.Args[1]
+		if v_1.Op != OpAMD64MOVSDconst {
+			break
+		}
+		y := v_1.AuxInt
+		check := v.Args[2]
+		if !(is64BitFloat(t)) {
+			break
+		}
+		v.reset(OpAMD64MOVQi2f)
+		v0 := b.NewValue0(v.Pos, OpCondSelect, typ.UInt64)
+		v1 := b.NewValue0(v.Pos, OpAMD64MOVQconst, typ.UInt64)
+		v1.AuxInt = x
+		v0.AddArg(v1)
+		v2 := b.NewValue0(v.Pos, OpAMD64MOVQconst, typ.UInt64)
+		v2.AuxInt = y
+		v0.AddArg(v2)
+		v0.AddArg(check)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (CondSelect <t> (Const32F [x]) (Const32F [y]) check)
+	// cond: is32BitFloat(t)
+	// result: (MOVLi2f (CondSelect <typ.UInt32> (MOVLconst [int64(int32(math.Float32bits(i2f32(x))))]) (MOVLconst [int64(int32(math.Float32bits(i2f32(y))))]) check))
+	for {
+		t := v.Type
+		_ = v.Args[2]
+		v_0 := v.Args[0]
+		if v_0.Op != OpConst32F {
+			break
+		}
+		x := v_0.AuxInt
+		v_1 := v.Args[1]
+		if v_1.Op != OpConst32F {
+			break
+		}
+		y := v_1.AuxInt
+		check := v.Args[2]
+		if !(is32BitFloat(t)) {
+			break
+		}
+		v.reset(OpAMD64MOVLi2f)
+		v0 := b.NewValue0(v.Pos, OpCondSelect, typ.UInt32)
+		v1 := b.NewValue0(v.Pos, OpAMD64MOVLconst, typ.UInt32)
+		v1.AuxInt = int64(int32(math.Float32bits(i2f32(x))))
+		v0.AddArg(v1)
+		v2 := b.NewValue0(v.Pos, OpAMD64MOVLconst, typ.UInt32)
+		v2.AuxInt = int64(int32(math.Float32bits(i2f32(y))))
+		v0.AddArg(v2)
+		v0.AddArg(check)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (CondSelect <t> (MOVSSconst [x]) (MOVSSconst [y]) check)
+	// cond: is32BitFloat(t)
+	// result: (MOVLi2f (CondSelect <typ.UInt32> (MOVLconst [int64(int32(math.Float32bits(i2f32(x))))]) (MOVLconst [int64(int32(math.Float32bits(i2f32(y))))]) check))
+	for {
+		t := v.Type
+		_ = v.Args[2]
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64MOVSSconst {
+			break
+		}
+		x := v_0.AuxInt
+		v_1 := v.Args[1]
+		if v_1.Op != OpAMD64MOVSSconst {
+			break
+		}
+		y := v_1.AuxInt
+		check := v.Args[2]
+		if !(is32BitFloat(t)) {
+			break
+		}
+		v.reset(OpAMD64MOVLi2f)
+		v0 := b.NewValue0(v.Pos, OpCondSelect, typ.UInt32)
+		v1 := b.NewValue0(v.Pos, OpAMD64MOVLconst, typ.UInt32)
+		v1.AuxInt = int64(int32(math.Float32bits(i2f32(x))))
+		v0.AddArg(v1)
+		v2 := b.NewValue0(v.Pos, OpAMD64MOVLconst, typ.UInt32)
+		v2.AuxInt = int64(int32(math.Float32bits(i2f32(y))))
+		v0.AddArg(v2)
+		v0.AddArg(check)
+		v.AddArg(v0)
+		return true
+	}
+	return false
+}
+func rewriteValueAMD64_OpCondSelect_50(v *Value) bool {
+	b := v.Block
+	_ = b
+	typ := &b.Func.Config.Types
+	_ = typ
 	// match: (CondSelect <t> x y check)
 	// cond: !check.Type.IsFlags() && check.Type.Size() == 1
 	// result: (CondSelect <t> x y (MOVBQZX <typ.UInt64> check))
@@ -47645,6 +50448,78 @@ func rewriteValueAMD64_OpConvert_0(v *Value) bool {
 	}
 	return false
 }
+func rewriteValueAMD64_OpCopysign_0(v *Value) bool {
+	b := v.Block
+	_ = b
+	typ := &b.Func.Config.Types
+	_ = typ
+	// match: (Copysign (Const64F [c]) y)
+	// cond:
+	// result: (ORPD (MOVSDconst <typ.Float64> [f2i(math.Abs(i2f(c)))]) (ANDPD <typ.Float64> y (MOVSDconst <typ.Float64> [f2i(math.Copysign(0, -1))])))
+	for {
+		_ = v.Args[1]
+		v_0 := v.Args[0]
+		if v_0.Op != OpConst64F {
+			break
+		}
+		c := v_0.AuxInt
+		y := v.Args[1]
+		v.reset(OpAMD64ORPD)
+		v0 := b.NewValue0(v.Pos, OpAMD64MOVSDconst, typ.Float64)
+		v0.AuxInt = f2i(math.Abs(i2f(c)))
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpAMD64ANDPD, typ.Float64)
+		v1.AddArg(y)
+		v2 := b.NewValue0(v.Pos, OpAMD64MOVSDconst, typ.Float64)
+		v2.AuxInt = f2i(math.Copysign(0, -1))
+		v1.AddArg(v2)
+		v.AddArg(v1)
+		return true
+	}
+	// match: (Copysign (MOVSDconst [c]) y)
+	// cond:
+	// result: (ORPD (MOVSDconst <typ.Float64> [f2i(math.Abs(i2f(c)))]) (ANDPD <typ.Float64> y (MOVSDconst <typ.Float64> [f2i(math.Copysign(0, -1))])))
+	for {
+		_ = v.Args[1]
+		v_0 := v.Args[0]
+		if v_0.Op != OpAMD64MOVSDconst {
+			break
+		}
+		c := v_0.AuxInt
+		y := v.Args[1]
+		v.reset(OpAMD64ORPD)
+		v0 := b.NewValue0(v.Pos, OpAMD64MOVSDconst, typ.Float64)
+		v0.AuxInt = f2i(math.Abs(i2f(c)))
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpAMD64ANDPD, typ.Float64)
+		v1.AddArg(y)
+		v2 := b.NewValue0(v.Pos, OpAMD64MOVSDconst, typ.Float64)
+		v2.AuxInt = f2i(math.Copysign(0, -1))
+		v1.AddArg(v2)
+		v.AddArg(v1)
+		return true
+	}
+	// match: (Copysign x y)
+	// cond:
+	// result: (PXOR x (ANDPD <typ.Float64> (PXOR <typ.Float64> x y) (MOVSDconst <typ.Float64> [f2i(math.Copysign(0, -1))])))
+	for {
+		_ = v.Args[1]
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpAMD64PXOR)
+		v.AddArg(x)
+		v0 := b.NewValue0(v.Pos, OpAMD64ANDPD, typ.Float64)
+		v1 := b.NewValue0(v.Pos, OpAMD64PXOR, typ.Float64)
+		v1.AddArg(x)
+		v1.AddArg(y)
+		v0.AddArg(v1)
+		v2 := b.NewValue0(v.Pos, OpAMD64MOVSDconst, typ.Float64)
+		v2.AuxInt = f2i(math.Copysign(0, -1))
+		v0.AddArg(v2)
+		v.AddArg(v0)
+		return true
+	}
+}
 func rewriteValueAMD64_OpCtz32_0(v *Value) bool {
 	b := v.Block
 	_ = b
@@ -47667,6 +50542,23 @@ func rewriteValueAMD64_OpCtz32_0(v *Value) bool {
 		return true
 	}
 }
+func rewriteValueAMD64_OpCtz32NonZero_0(v *Value) bool {
+	b := v.Block
+	_ = b
+	typ := &b.Func.Config.Types
+	_ = typ
+	// match: (Ctz32NonZero x)
+	// cond:
+	// result: (Select0 (BSFL x))
+	for {
+		x := v.Args[0]
+		v.reset(OpSelect0)
+		v0 := b.NewValue0(v.Pos, OpAMD64BSFL, types.NewTuple(typ.UInt32, types.TypeFlags))
+		v0.AddArg(x)
+		v.AddArg(v0)
+		return true
+	}
+}
 func rewriteValueAMD64_OpCtz64_0(v *Value) bool {
 	b := v.Block
 	_ = b
@@ -47695,6 +50587,23 @@ func rewriteValueAMD64_OpCtz64_0(v *Value) bool {
 		return true
 	}
 }
+func rewriteValueAMD64_OpCtz64NonZero_0(v *Value) bool {
+	b := v.Block
+	_ = b
+	typ := &b.Func.Config.Types
+	_ = typ
+	// match: (Ctz64NonZero x)
+	// cond:
+	// result: (Select0 (BSFQ x))
+	for {
+		x := v.Args[0]
+		v.reset(OpSelect0)
+		v0 := b.NewValue0(v.Pos, OpAMD64BSFQ, types.NewTuple(typ.UInt64, types.TypeFlags))
+		v0.AddArg(x)
+		v.AddArg(v0)
+		return true
+	}
+}
 func rewriteValueAMD64_OpCvt32Fto32_0(v *Value) bool {
 	// match: (Cvt32Fto32 x)
 	// cond:
@@ -48143,6 +51052,38 @@ func rewriteValueAMD64_OpEqB_0(v *Value) bool {
 		return true
 	}
 }
+func rewriteValueAMD64_OpEqMem128_0(v *Value) bool {
+	b := v.Block
+	_ = b
+	typ := &b.Func.Config.Types
+	_ = typ
+	// match: (EqMem128 p q mem)
+	// cond:
+	// result: (SETEQ (CMPLconst [0xffff] (PMOVMSKB (PCMPEQB (MOVOload p mem) (MOVOload q mem)))))
+	for {
+		_ = v.Args[2]
+		p := v.Args[0]
+		q := v.Args[1]
+		mem := v.Args[2]
+		v.reset(OpAMD64SETEQ)
+		v0 := b.NewValue0(v.Pos, OpAMD64CMPLconst, types.TypeFlags)
+		v0.AuxInt = 0xffff
+		v1 := b.NewValue0(v.Pos, OpAMD64PMOVMSKB, typ.UInt32)
+		v2 := b.NewValue0(v.Pos, OpAMD64PCMPEQB, types.TypeInt128)
+		v3 := b.NewValue0(v.Pos, OpAMD64MOVOload, types.TypeInt128)
+		v3.AddArg(p)
+		v3.AddArg(mem)
+		v2.AddArg(v3)
+		v4 := b.NewValue0(v.Pos, OpAMD64MOVOload, types.TypeInt128)
+		v4.AddArg(q)
+		v4.AddArg(mem)
+		v2.AddArg(v4)
+		v1.AddArg(v2)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+}
 func rewriteValueAMD64_OpEqPtr_0(v *Value) bool {
 	b := v.Block
 	_ = b
@@ -50826,11 +53767,11 @@ func rewriteValueAMD64_OpPopCount8_0(v *Value) bool {
 	_ = typ
 	// match: (PopCount8 x)
 	// cond:
-	// result: (POPCNTL (MOVBQZX <typ.UInt32> x))
+	// result: (LoweredPopCount8 (MOVBQZX <typ.UInt64> x))
 	for {
 		x := v.Args[0]
-		v.reset(OpAMD64POPCNTL)
-		v0 := b.NewValue0(v.Pos, OpAMD64MOVBQZX, typ.UInt32)
+		v.reset(OpAMD64LoweredPopCount8)
+		v0 := b.NewValue0(v.Pos, OpAMD64MOVBQZX, typ.UInt64)
 		v0.AddArg(x)
 		v.AddArg(v0)
 		return true
@@ -53042,7 +55983,7 @@ func rewriteBlockAMD64(b *Block) bool {
 			return true
 		}
 		// match: (EQ (TESTLconst [c] x))
-		// cond: isUint32PowerOfTwo(c) && !config.nacl
+		// cond: isUint32PowerOfTwo(c) && log2uint32(c) >= 7 && !config.nacl
 		// result: (UGE (BTLconst [log2uint32(c)] x))
 		for {
 			v := b.Control
@@ -53051,7 +55992,7 @@ func rewriteBlockAMD64(b *Block) bool {
 			}
 			c := v.AuxInt
 			x := v.Args[0]
-			if !(isUint32PowerOfTwo(c) && !config.nacl) {
+			if !(isUint32PowerOfTwo(c) && log2uint32(c) >= 7 && !config.nacl) {
 				break
 			}
 			b.Kind = BlockAMD64UGE
@@ -53063,7 +56004,7 @@ func rewriteBlockAMD64(b *Block) bool {
 			return true
 		}
 		// match: (EQ (TESTQconst [c] x))
-		// cond: isUint64PowerOfTwo(c) && !config.nacl
+		// cond: isUint64PowerOfTwo(c) && log2(c) >= 7 && !config.nacl
 		// result: (UGE (BTQconst [log2(c)] x))
 		for {
 			v := b.Control
@@ -53072,7 +56013,7 @@ func rewriteBlockAMD64(b *Block) bool {
 			}
 			c := v.AuxInt
 			x := v.Args[0]
-			if !(isUint64PowerOfTwo(c) && !config.nacl) {
+			if !(isUint64PowerOfTwo(c) && log2(c) >= 7 && !config.nacl) {
 				break
 			}
 			b.Kind = BlockAMD64UGE
@@ -53084,7 +56025,7 @@ func rewriteBlockAMD64(b *Block) bool {
 			return true
 		}
 		// match: (EQ (TESTQ (MOVQconst [c]) x))
-		// cond: isUint64PowerOfTwo(c) && !config.nacl
+		// cond: isUint64PowerOfTwo(c) && log2(c) >= 7 && !config.nacl
 		// result: (UGE (BTQconst [log2(c)] x))
 		for {
 			v := b.Control
@@ -53098,7 +56039,7 @@ func rewriteBlockAMD64(b *Block) bool {
 			}
 			c := v_0.AuxInt
 			x := v.Args[1]
-			if !(isUint64PowerOfTwo(c) && !config.nacl) {
+			if !(isUint64PowerOfTwo(c) && log2(c) >= 7 && !config.nacl) {
 				break
 			}
 			b.Kind = BlockAMD64UGE
@@ -53110,7 +56051,7 @@ func rewriteBlockAMD64(b *Block) bool {
 			return true
 		}
 		// match: (EQ (TESTQ x (MOVQconst [c])))
-		// cond: isUint64PowerOfTwo(c) && !config.nacl
+		// cond: isUint64PowerOfTwo(c) && log2(c) >= 7 && !config.nacl
 		// result: (UGE (BTQconst [log2(c)] x))
 		for {
 			v := b.Control
@@ -53124,7 +56065,7 @@ func rewriteBlockAMD64(b *Block) bool {
 				break
 			}
 			c := v_1.AuxInt
-			if !(isUint64PowerOfTwo(c) && !config.nacl) {
+			if !(isUint64PowerOfTwo(c) && log2(c) >= 7 && !config.nacl) {
 				break
 			}
 			b.Kind = BlockAMD64UGE
@@ -53218,6 +56159,154 @@ func rewriteBlockAMD64(b *Block) bool {
 			b.swapSuccessors()
 			return true
 		}
+		// match: (EQ (TESTQconst [1] (LoweredPopCount8 x)) yes no)
+		// cond:
+		// result: (NAN (TESTB x x) yes no)
+		for {
+			v := b.Control
+			if v.Op != OpAMD64TESTQconst {
+				break
+			}
+			if v.AuxInt != 1 {
+				break
+			}
+			v_0 := v.Args[0]
+			if v_0.Op != OpAMD64LoweredPopCount8 {
+				break
+			}
+			x := v_0.Args[0]
+			b.Kind = BlockAMD64NAN
+			v0 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+			v0.AddArg(x)
+			v0.AddArg(x)
+			b.SetControl(v0)
+			b.Aux = nil
+			return true
+		}
+		// match: (EQ (TESTLconst [1] (LoweredPopCount8 x)) yes no)
+		// cond:
+		// result: (NAN (TESTB x x) yes no)
+		for {
+			v := b.Control
+			if v.Op != OpAMD64TESTLconst {
+				break
+			}
+			if v.AuxInt != 1 {
+				break
+			}
+			v_0 := v.Args[0]
+			if v_0.Op != OpAMD64LoweredPopCount8 {
+				break
+			}
+			x := v_0.Args[0]
+			b.Kind = BlockAMD64NAN
+			v0 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+			v0.AddArg(x)
+			v0.AddArg(x)
+			b.SetControl(v0)
+			b.Aux = nil
+			return true
+		}
+		// match: (EQ (TESTWconst [1] (LoweredPopCount8 x)) yes no)
+		// cond:
+		// result: (NAN (TESTB x x) yes no)
+		for {
+			v := b.Control
+			if v.Op != OpAMD64TESTWconst {
+				break
+			}
+			if v.AuxInt != 1 {
+				break
+			}
+			v_0 := v.Args[0]
+			if v_0.Op != OpAMD64LoweredPopCount8 {
+				break
+			}
+			x := v_0.Args[0]
+			b.Kind = BlockAMD64NAN
+			v0 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+			v0.AddArg(x)
+			v0.AddArg(x)
+			b.SetControl(v0)
+			b.Aux = nil
+			return true
+		}
+		// match: (EQ (TESTBconst [1] (LoweredPopCount8 x)) yes no)
+		// cond:
+		// result: (NAN (TESTB x x) yes no)
+		for {
+			v := b.Control
+			if v.Op != OpAMD64TESTBconst {
+				break
+			}
+			if v.AuxInt != 1 {
+				break
+			}
+			v_0 := v.Args[0]
+			if v_0.Op != OpAMD64LoweredPopCount8 {
+				break
+			}
+			x := v_0.Args[0]
+			b.Kind = BlockAMD64NAN
+			v0 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+			v0.AddArg(x)
+			v0.AddArg(x)
+			b.SetControl(v0)
+			b.Aux = nil
+			return true
+		}
+		// match: (EQ (TESTB (SETORD f) (SETORD f)) yes no)
+		// cond:
+		// result: (NAN f yes no)
+		for {
+			v := b.Control
+			if v.Op != OpAMD64TESTB {
+				break
+			}
+			_ = v.Args[1]
+			v_0 := v.Args[0]
+			if v_0.Op != OpAMD64SETORD {
+				break
+			}
+			f := v_0.Args[0]
+			v_1 := v.Args[1]
+			if v_1.Op != OpAMD64SETORD {
+				break
+			}
+			if f != v_1.Args[0] {
+				break
+			}
+			b.Kind = BlockAMD64NAN
+			b.SetControl(f)
+			b.Aux = nil
+			return true
+		}
+		// match: (EQ (TESTB (SETORD f) (SETORD f)) yes no)
+		// cond:
+		// result: (NAN f yes no)
+		for {
+			v := b.Control
+			if v.Op != OpAMD64TESTB {
+				break
+			}
+			_ = v.Args[1]
+			v_0 := v.Args[0]
+			if v_0.Op != OpAMD64SETORD {
+				break
+			}
+			f := v_0.Args[0]
+			v_1 := v.Args[1]
+			if v_1.Op != OpAMD64SETORD {
+				break
+			}
+			if f != v_1.Args[0] {
+				break
+			}
+			b.Kind = BlockAMD64NAN
+			b.SetControl(f)
+			b.Aux = nil
+			return true
+		}
 	case BlockAMD64GE:
 		// match: (GE (InvertFlags cmp) yes no)
 		// cond:
@@ -54418,7 +57507,7 @@ func rewriteBlockAMD64(b *Block) bool {
 			return true
 		}
 		// match: (NE (TESTLconst [c] x))
-		// cond: isUint32PowerOfTwo(c) && !config.nacl
+		// cond: isUint32PowerOfTwo(c) && log2uint32(c) >= 7 && !config.nacl
 		// result: (ULT (BTLconst [log2uint32(c)] x))
 		for {
 			v := b.Control
@@ -54427,7 +57516,7 @@ func rewriteBlockAMD64(b *Block) bool {
 			}
 			c := v.AuxInt
 			x := v.Args[0]
-			if !(isUint32PowerOfTwo(c) && !config.nacl) {
+			if !(isUint32PowerOfTwo(c) && log2uint32(c) >= 7 && !config.nacl) {
 				break
 			}
 			b.Kind = BlockAMD64ULT
@@ -54439,7 +57528,7 @@ func rewriteBlockAMD64(b *Block) bool {
 			return true
 		}
 		// match: (NE (TESTQconst [c] x))
-		// cond: isUint64PowerOfTwo(c) && !config.nacl
+		// cond: isUint64PowerOfTwo(c) && log2(c) >= 7 && !config.nacl
 		// result: (ULT (BTQconst [log2(c)] x))
 		for {
 			v := b.Control
@@ -54448,7 +57537,7 @@ func rewriteBlockAMD64(b *Block) bool {
 			}
 			c := v.AuxInt
 			x := v.Args[0]
-			if !(isUint64PowerOfTwo(c) && !config.nacl) {
+			if !(isUint64PowerOfTwo(c) && log2(c) >= 7 && !config.nacl) {
 				break
 			}
 			b.Kind = BlockAMD64ULT
@@ -54460,7 +57549,7 @@ func rewriteBlockAMD64(b *Block) bool {
 			return true
 		}
 		// match: (NE (TESTQ (MOVQconst [c]) x))
-		// cond: isUint64PowerOfTwo(c) && !config.nacl
+		// cond: isUint64PowerOfTwo(c) && log2(c) >= 7 && !config.nacl
 		// result: (ULT (BTQconst [log2(c)] x))
 		for {
 			v := b.Control
@@ -54474,7 +57563,7 @@ func rewriteBlockAMD64(b *Block) bool {
 			}
 			c := v_0.AuxInt
 			x := v.Args[1]
-			if !(isUint64PowerOfTwo(c) && !config.nacl) {
+			if !(isUint64PowerOfTwo(c) && log2(c) >= 7 && !config.nacl) {
 				break
 			}
 			b.Kind = BlockAMD64ULT
@@ -54486,7 +57575,7 @@ func rewriteBlockAMD64(b *Block) bool {
 			return true
 		}
 		// match: (NE (TESTQ x (MOVQconst [c])))
-		// cond: isUint64PowerOfTwo(c) && !config.nacl
+		// cond: isUint64PowerOfTwo(c) && log2(c) >= 7 && !config.nacl
 		// result: (ULT (BTQconst [log2(c)] x))
 		for {
 			v := b.Control
@@ -54500,7 +57589,7 @@ func rewriteBlockAMD64(b *Block) bool {
 				break
 			}
 			c := v_1.AuxInt
-			if !(isUint64PowerOfTwo(c) && !config.nacl) {
+			if !(isUint64PowerOfTwo(c) && log2(c) >= 7 && !config.nacl) {
 				break
 			}
 			b.Kind = BlockAMD64ULT
@@ -54799,6 +57888,154 @@ func rewriteBlockAMD64(b *Block) bool {
 			b.Aux = nil
 			return true
 		}
+		// match: (NE (TESTQconst [1] (LoweredPopCount8 x)) yes no)
+		// cond:
+		// result: (ORD (TESTB x x) yes no)
+		for {
+			v := b.Control
+			if v.Op != OpAMD64TESTQconst {
+				break
+			}
+			if v.AuxInt != 1 {
+				break
+			}
+			v_0 := v.Args[0]
+			if v_0.Op != OpAMD64LoweredPopCount8 {
+				break
+			}
+			x := v_0.Args[0]
+			b.Kind = BlockAMD64ORD
+			v0 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+			v0.AddArg(x)
+			v0.AddArg(x)
+			b.SetControl(v0)
+			b.Aux = nil
+			return true
+		}
+		// match: (NE (TESTLconst [1] (LoweredPopCount8 x)) yes no)
+		// cond:
+		// result: (ORD (TESTB x x) yes no)
+		for {
+			v := b.Control
+			if v.Op != OpAMD64TESTLconst {
+				break
+			}
+			if v.AuxInt != 1 {
+				break
+			}
+			v_0 := v.Args[0]
+			if v_0.Op != OpAMD64LoweredPopCount8 {
+				break
+			}
+			x := v_0.Args[0]
+			b.Kind = BlockAMD64ORD
+			v0 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+			v0.AddArg(x)
+			v0.AddArg(x)
+			b.SetControl(v0)
+			b.Aux = nil
+			return true
+		}
+		// match: (NE (TESTWconst [1] (LoweredPopCount8 x)) yes no)
+		// cond:
+		// result: (ORD (TESTB x x) yes no)
+		for {
+			v := b.Control
+			if v.Op != OpAMD64TESTWconst {
+				break
+			}
+			if v.AuxInt != 1 {
+				break
+			}
+			v_0 := v.Args[0]
+			if v_0.Op != OpAMD64LoweredPopCount8 {
+				break
+			}
+			x := v_0.Args[0]
+			b.Kind = BlockAMD64ORD
+			v0 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+			v0.AddArg(x)
+			v0.AddArg(x)
+			b.SetControl(v0)
+			b.Aux = nil
+			return true
+		}
+		// match: (NE (TESTBconst [1] (LoweredPopCount8 x)) yes no)
+		// cond:
+		// result: (ORD (TESTB x x) yes no)
+		for {
+			v := b.Control
+			if v.Op != OpAMD64TESTBconst {
+				break
+			}
+			if v.AuxInt != 1 {
+				break
+			}
+			v_0 := v.Args[0]
+			if v_0.Op != OpAMD64LoweredPopCount8 {
+				break
+			}
+			x := v_0.Args[0]
+			b.Kind = BlockAMD64ORD
+			v0 := b.NewValue0(v.Pos, OpAMD64TESTB, types.TypeFlags)
+			v0.AddArg(x)
+			v0.AddArg(x)
+			b.SetControl(v0)
+			b.Aux = nil
+			return true
+		}
+		// match: (NE (TESTB (SETORD f) (SETORD f)) yes no)
+		// cond:
+		// result: (ORD f yes no)
+		for {
+			v := b.Control
+			if v.Op != OpAMD64TESTB {
+				break
+			}
+			_ = v.Args[1]
+			v_0 := v.Args[0]
+			if v_0.Op != OpAMD64SETORD {
+				break
+			}
+			f := v_0.Args[0]
+			v_1 := v.Args[1]
+			if v_1.Op != OpAMD64SETORD {
+				break
+			}
+			if f != v_1.Args[0] {
+				break
+			}
+			b.Kind = BlockAMD64ORD
+			b.SetControl(f)
+			b.Aux = nil
+			return true
+		}
+		// match: (NE (TESTB (SETORD f) (SETORD f)) yes no)
+		// cond:
+		// result: (ORD f yes no)
+		for {
+			v := b.Control
+			if v.Op != OpAMD64TESTB {
+				break
+			}
+			_ = v.Args[1]
+			v_0 := v.Args[0]
+			if v_0.Op != OpAMD64SETORD {
+				break
+			}
+			f := v_0.Args[0]
+			v_1 := v.Args[1]
+			if v_1.Op != OpAMD64SETORD {
+				break
+			}
+			if f != v_1.Args[0] {
+				break
+			}
+			b.Kind = BlockAMD64ORD
+			b.SetControl(f)
+			b.Aux = nil
+			return true
+		}
 	case BlockAMD64UGE:
 		// match: (UGE (InvertFlags cmp) yes no)
 		// cond:
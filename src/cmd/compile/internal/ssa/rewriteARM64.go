@@ -16,7 +16,7 @@ var _ = types.TypeMem // in case not otherwise used
 func rewriteValueARM64(v *Value) bool {
 	switch v.Op {
 	case OpARM64ADD:
-		return rewriteValueARM64_OpARM64ADD_0(v)
+		return rewriteValueARM64_OpARM64ADD_0(v) || rewriteValueARM64_OpARM64ADD_10(v)
 	case OpARM64ADDconst:
 		return rewriteValueARM64_OpARM64ADDconst_0(v)
 	case OpARM64ADDshiftLL:
@@ -43,6 +43,14 @@ func rewriteValueARM64(v *Value) bool {
 		return rewriteValueARM64_OpARM64BICshiftRA_0(v)
 	case OpARM64BICshiftRL:
 		return rewriteValueARM64_OpARM64BICshiftRL_0(v)
+	case OpARM64CMN:
+		return rewriteValueARM64_OpARM64CMN_0(v)
+	case OpARM64CMNshiftLL:
+		return rewriteValueARM64_OpARM64CMNshiftLL_0(v)
+	case OpARM64CMNshiftRA:
+		return rewriteValueARM64_OpARM64CMNshiftRA_0(v)
+	case OpARM64CMNshiftRL:
+		return rewriteValueARM64_OpARM64CMNshiftRL_0(v)
 	case OpARM64CMP:
 		return rewriteValueARM64_OpARM64CMP_0(v)
 	case OpARM64CMPW:
@@ -61,6 +69,8 @@ func rewriteValueARM64(v *Value) bool {
 		return rewriteValueARM64_OpARM64CSEL_0(v)
 	case OpARM64CSEL0:
 		return rewriteValueARM64_OpARM64CSEL0_0(v)
+	case OpARM64CSINC:
+		return rewriteValueARM64_OpARM64CSINC_0(v)
 	case OpARM64DIV:
 		return rewriteValueARM64_OpARM64DIV_0(v)
 	case OpARM64DIVW:
@@ -75,6 +85,8 @@ func rewriteValueARM64(v *Value) bool {
 		return rewriteValueARM64_OpARM64EONshiftRL_0(v)
 	case OpARM64Equal:
 		return rewriteValueARM64_OpARM64Equal_0(v)
+	case OpARM64FABSD:
+		return rewriteValueARM64_OpARM64FABSD_0(v)
 	case OpARM64FADDD:
 		return rewriteValueARM64_OpARM64FADDD_0(v)
 	case OpARM64FADDS:
@@ -218,7 +230,7 @@ func rewriteValueARM64(v *Value) bool {
 	case OpARM64STP:
 		return rewriteValueARM64_OpARM64STP_0(v)
 	case OpARM64SUB:
-		return rewriteValueARM64_OpARM64SUB_0(v)
+		return rewriteValueARM64_OpARM64SUB_0(v) || rewriteValueARM64_OpARM64SUB_10(v)
 	case OpARM64SUBconst:
 		return rewriteValueARM64_OpARM64SUBconst_0(v)
 	case OpARM64SUBshiftLL:
@@ -245,6 +257,8 @@ func rewriteValueARM64(v *Value) bool {
 		return rewriteValueARM64_OpARM64XORshiftRA_0(v)
 	case OpARM64XORshiftRL:
 		return rewriteValueARM64_OpARM64XORshiftRL_0(v)
+	case OpAbs:
+		return rewriteValueARM64_OpAbs_0(v)
 	case OpAdd16:
 		return rewriteValueARM64_OpAdd16_0(v)
 	case OpAdd32:
@@ -349,8 +363,12 @@ func rewriteValueARM64(v *Value) bool {
 		return rewriteValueARM64_OpConvert_0(v)
 	case OpCtz32:
 		return rewriteValueARM64_OpCtz32_0(v)
+	case OpCtz32NonZero:
+		return rewriteValueARM64_OpCtz32NonZero_0(v)
 	case OpCtz64:
 		return rewriteValueARM64_OpCtz64_0(v)
+	case OpCtz64NonZero:
+		return rewriteValueARM64_OpCtz64NonZero_0(v)
 	case OpCvt32Fto32:
 		return rewriteValueARM64_OpCvt32Fto32_0(v)
 	case OpCvt32Fto32U:
@@ -817,6 +835,185 @@ func rewriteValueARM64_OpARM64ADD_0(v *Value) bool {
 		v.AddArg(x)
 		return true
 	}
+	// match: (ADD a l:(MUL x y))
+	// cond: l.Uses==1 && x.Op != OpARM64MOVDconst && y.Op != OpARM64MOVDconst && clobber(l)
+	// result: (MADD a x y)
+	for {
+		_ = v.Args[1]
+		a := v.Args[0]
+		l := v.Args[1]
+		if l.Op != OpARM64MUL {
+			break
+		}
+		_ = l.Args[1]
+		x := l.Args[0]
+		y := l.Args[1]
+		if !(l.Uses == 1 && x.Op != OpARM64MOVDconst && y.Op != OpARM64MOVDconst && clobber(l)) {
+			break
+		}
+		v.reset(OpARM64MADD)
+		v.AddArg(a)
+		v.AddArg(x)
+		v.AddArg(y)
+		return true
+	}
+	// match: (ADD l:(MUL x y) a)
+	// cond: l.Uses==1 && x.Op != OpARM64MOVDconst && y.Op != OpARM64MOVDconst && clobber(l)
+	// result: (MADD a x y)
+	for {
+		_ = v.Args[1]
+		l := v.Args[0]
+		if l.Op != OpARM64MUL {
+			break
+		}
+		_ = l.Args[1]
+		x := l.Args[0]
+		y := l.Args[1]
+		a := v.Args[1]
+		if !(l.Uses == 1 && x.Op != OpARM64MOVDconst && y.Op != OpARM64MOVDconst && clobber(l)) {
+			break
+		}
+		v.reset(OpARM64MADD)
+		v.AddArg(a)
+		v.AddArg(x)
+		v.AddArg(y)
+		return true
+	}
+	// match: (ADD a l:(MNEG x y))
+	// cond: l.Uses==1 && x.Op != OpARM64MOVDconst && y.Op != OpARM64MOVDconst && clobber(l)
+	// result: (MSUB a x y)
+	for {
+		_ = v.Args[1]
+		a := v.Args[0]
+		l := v.Args[1]
+		if l.Op != OpARM64MNEG {
+			break
+		}
+		_ = l.Args[1]
+		x := l.Args[0]
+		y := l.Args[1]
+		if !(l.Uses == 1 && x.Op != OpARM64MOVDconst && y.Op != OpARM64MOVDconst && clobber(l)) {
+			break
+		}
+		v.reset(OpARM64MSUB)
+		v.AddArg(a)
+		v.AddArg(x)
+		v.AddArg(y)
+		return true
+	}
+	// match: (ADD l:(MNEG x y) a)
+	// cond: l.Uses==1 && x.Op != OpARM64MOVDconst && y.Op != OpARM64MOVDconst && clobber(l)
+	// result: (MSUB a x y)
+	for {
+		_ = v.Args[1]
+		l := v.Args[0]
+		if l.Op != OpARM64MNEG {
+			break
+		}
+		_ = l.Args[1]
+		x := l.Args[0]
+		y := l.Args[1]
+		a := v.Args[1]
+		if !(l.Uses == 1 && x.Op != OpARM64MOVDconst && y.Op != OpARM64MOVDconst && clobber(l)) {
+			break
+		}
+		v.reset(OpARM64MSUB)
+		v.AddArg(a)
+		v.AddArg(x)
+		v.AddArg(y)
+		return true
+	}
+	// match: (ADD a l:(MULW x y))
+	// cond: a.Type.Size() != 8 && l.Uses==1 && x.Op != OpARM64MOVDconst && y.Op != OpARM64MOVDconst && clobber(l)
+	// result: (MADDW a x y)
+	for {
+		_ = v.Args[1]
+		a := v.Args[0]
+		l := v.Args[1]
+		if l.Op != OpARM64MULW {
+			break
+		}
+		_ = l.Args[1]
+		x := l.Args[0]
+		y := l.Args[1]
+		if !(a.Type.Size() != 8 && l.Uses == 1 && x.Op != OpARM64MOVDconst && y.Op != OpARM64MOVDconst && clobber(l)) {
+			break
+		}
+		v.reset(OpARM64MADDW)
+		v.AddArg(a)
+		v.AddArg(x)
+		v.AddArg(y)
+		return true
+	}
+	// match: (ADD l:(MULW x y) a)
+	// cond: a.Type.Size() != 8 && l.Uses==1 && x.Op != OpARM64MOVDconst && y.Op != OpARM64MOVDconst && clobber(l)
+	// result: (MADDW a x y)
+	for {
+		_ = v.Args[1]
+		l := v.Args[0]
+		if l.Op != OpARM64MULW {
+			break
+		}
+		_ = l.Args[1]
+		x := l.Args[0]
+		y := l.Args[1]
+		a := v.Args[1]
+		if !(a.Type.Size() != 8 && l.Uses == 1 && x.Op != OpARM64MOVDconst && y.Op != OpARM64MOVDconst && clobber(l)) {
+			break
+		}
+		v.reset(OpARM64MADDW)
+		v.AddArg(a)
+		v.AddArg(x)
+		v.AddArg(y)
+		return true
+	}
+	// match: (ADD a l:(MNEGW x y))
+	// cond: a.Type.Size() != 8 && l.Uses==1 && x.Op != OpARM64MOVDconst && y.Op != OpARM64MOVDconst && clobber(l)
+	// result: (MSUBW a x y)
+	for {
+		_ = v.Args[1]
+		a := v.Args[0]
+		l := v.Args[1]
+		if l.Op != OpARM64MNEGW {
+			break
+		}
+		_ = l.Args[1]
+		x := l.Args[0]
+		y := l.Args[1]
+		if !(a.Type.Size() != 8 && l.Uses == 1 && x.Op != OpARM64MOVDconst && y.Op != OpARM64MOVDconst && clobber(l)) {
+			break
+		}
+		v.reset(OpARM64MSUBW)
+		v.AddArg(a)
+		v.AddArg(x)
+		v.AddArg(y)
+		return true
+	}
+	// match: (ADD l:(MNEGW x y) a)
+	// cond: a.Type.Size() != 8 && l.Uses==1 && x.Op != OpARM64MOVDconst && y.Op != OpARM64MOVDconst && clobber(l)
+	// result: (MSUBW a x y)
+	for {
+		_ = v.Args[1]
+		l := v.Args[0]
+		if l.Op != OpARM64MNEGW {
+			break
+		}
+		_ = l.Args[1]
+		x := l.Args[0]
+		y := l.Args[1]
+		a := v.Args[1]
+		if !(a.Type.Size() != 8 && l.Uses == 1 && x.Op != OpARM64MOVDconst && y.Op != OpARM64MOVDconst && clobber(l)) {
+			break
+		}
+		v.reset(OpARM64MSUBW)
+		v.AddArg(a)
+		v.AddArg(x)
+		v.AddArg(y)
+		return true
+	}
+	return false
+}
+func rewriteValueARM64_OpARM64ADD_10(v *Value) bool {
 	// match: (ADD x (NEG y))
 	// cond:
 	// result: (SUB x y)
@@ -1970,6 +2167,293 @@ func rewriteValueARM64_OpARM64BICshiftRL_0(v *Value) bool {
 	}
 	return false
 }
+func rewriteValueARM64_OpARM64CMN_0(v *Value) bool {
+	// match: (CMN x (MOVDconst [c]))
+	// cond:
+	// result: (CMNconst [c] x)
+	for {
+		_ = v.Args[1]
+		x := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpARM64MOVDconst {
+			break
+		}
+		c := v_1.AuxInt
+		v.reset(OpARM64CMNconst)
+		v.AuxInt = c
+		v.AddArg(x)
+		return true
+	}
+	// match: (CMN (MOVDconst [c]) x)
+	// cond:
+	// result: (CMNconst [c] x)
+	for {
+		_ = v.Args[1]
+		v_0 := v.Args[0]
+		if v_0.Op != OpARM64MOVDconst {
+			break
+		}
+		c := v_0.AuxInt
+		x := v.Args[1]
+		v.reset(OpARM64CMNconst)
+		v.AuxInt = c
+		v.AddArg(x)
+		return true
+	}
+	// match: (CMN x0 x1:(SLLconst [c] y))
+	// cond: x1.Uses == 1 && clobber(x1)
+	// result: (CMNshiftLL x0 y [c])
+	for {
+		_ = v.Args[1]
+		x0 := v.Args[0]
+		x1 := v.Args[1]
+		if x1.Op != OpARM64SLLconst {
+			break
+		}
+		c := x1.AuxInt
+		y := x1.Args[0]
+		if !(x1.Uses == 1 && clobber(x1)) {
+			break
+		}
+		v.reset(OpARM64CMNshiftLL)
+		v.AuxInt = c
+		v.AddArg(x0)
+		v.AddArg(y)
+		return true
+	}
+	// match: (CMN x1:(SLLconst [c] y) x0)
+	// cond: x1.Uses == 1 && clobber(x1)
+	// result: (CMNshiftLL x0 y [c])
+	for {
+		_ = v.Args[1]
+		x1 := v.Args[0]
+		if x1.Op != OpARM64SLLconst {
+			break
+		}
+		c := x1.AuxInt
+		y := x1.Args[0]
+		x0 := v.Args[1]
+		if !(x1.Uses == 1 && clobber(x1)) {
+			break
+		}
+		v.reset(OpARM64CMNshiftLL)
+		v.AuxInt = c
+		v.AddArg(x0)
+		v.AddArg(y)
+		return true
+	}
+	// match: (CMN x0 x1:(SRLconst [c] y))
+	// cond: x1.Uses == 1 && clobber(x1)
+	// result: (CMNshiftRL x0 y [c])
+	for {
+		_ = v.Args[1]
+		x0 := v.Args[0]
+		x1 := v.Args[1]
+		if x1.Op != OpARM64SRLconst {
+			break
+		}
+		c := x1.AuxInt
+		y := x1.Args[0]
+		if !(x1.Uses == 1 && clobber(x1)) {
+			break
+		}
+		v.reset(OpARM64CMNshiftRL)
+		v.AuxInt = c
+		v.AddArg(x0)
+		v.AddArg(y)
+		return true
+	}
+	// match: (CMN x1:(SRLconst [c] y) x0)
+	// cond: x1.Uses == 1 && clobber(x1)
+	// result: (CMNshiftRL x0 y [c])
+	for {
+		_ = v.Args[1]
+		x1 := v.Args[0]
+		if x1.Op != OpARM64SRLconst {
+			break
+		}
+		c := x1.AuxInt
+		y := x1.Args[0]
+		x0 := v.Args[1]
+		if !(x1.Uses == 1 && clobber(x1)) {
+			break
+		}
+		v.reset(OpARM64CMNshiftRL)
+		v.AuxInt = c
+		v.AddArg(x0)
+		v.AddArg(y)
+		return true
+	}
+	// match: (CMN x0 x1:(SRAconst [c] y))
+	// cond: x1.Uses == 1 && clobber(x1)
+	// result: (CMNshiftRA x0 y [c])
+	for {
+		_ = v.Args[1]
+		x0 := v.Args[0]
+		x1 := v.Args[1]
+		if x1.Op != OpARM64SRAconst {
+			break
+		}
+		c := x1.AuxInt
+		y := x1.Args[0]
+		if !(x1.Uses == 1 && clobber(x1)) {
+			break
+		}
+		v.reset(OpARM64CMNshiftRA)
+		v.AuxInt = c
+		v.AddArg(x0)
+		v.AddArg(y)
+		return true
+	}
+	// match: (CMN x1:(SRAconst [c] y) x0)
+	// cond: x1.Uses == 1 && clobber(x1)
+	// result: (CMNshiftRA x0 y [c])
+	for {
+		_ = v.Args[1]
+		x1 := v.Args[0]
+		if x1.Op != OpARM64SRAconst {
+			break
+		}
+		c := x1.AuxInt
+		y := x1.Args[0]
+		x0 := v.Args[1]
+		if !(x1.Uses == 1 && clobber(x1)) {
+			break
+		}
+		v.reset(OpARM64CMNshiftRA)
+		v.AuxInt = c
+		v.AddArg(x0)
+		v.AddArg(y)
+		return true
+	}
+	return false
+}
+func rewriteValueARM64_OpARM64CMNshiftLL_0(v *Value) bool {
+	b := v.Block
+	_ = b
+	// match: (CMNshiftLL (MOVDconst [c]) x [d])
+	// cond:
+	// result: (CMNconst [c] (SLLconst <x.Type> x [d]))
+	for {
+		d := v.AuxInt
+		_ = v.Args[1]
+		v_0 := v.Args[0]
+		if v_0.Op != OpARM64MOVDconst {
+			break
+		}
+		c := v_0.AuxInt
+		x := v.Args[1]
+		v.reset(OpARM64CMNconst)
+		v.AuxInt = c
+		v0 := b.NewValue0(v.Pos, OpARM64SLLconst, x.Type)
+		v0.AuxInt = d
+		v0.AddArg(x)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (CMNshiftLL x (MOVDconst [c]) [d])
+	// cond:
+	// result: (CMNconst x [int64(uint64(c)<<uint64(d))])
+	for {
+		d := v.AuxInt
+		_ = v.Args[1]
+		x := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpARM64MOVDconst {
+			break
+		}
+		c := v_1.AuxInt
+		v.reset(OpARM64CMNconst)
+		v.AuxInt = int64(uint64(c) << uint64(d))
+		v.AddArg(x)
+		return true
+	}
+	return false
+}
+func rewriteValueARM64_OpARM64CMNshiftRA_0(v *Value) bool {
+	b := v.Block
+	_ = b
+	// match: (CMNshiftRA (MOVDconst [c]) x [d])
+	// cond:
+	// result: (CMNconst [c] (SRAconst <x.Type> x [d]))
+	for {
+		d := v.AuxInt
+		_ = v.Args[1]
+		v_0 := v.Args[0]
+		if v_0.Op != OpARM64MOVDconst {
+			break
+		}
+		c := v_0.AuxInt
+		x := v.Args[1]
+		v.reset(OpARM64CMNconst)
+		v.AuxInt = c
+		v0 := b.NewValue0(v.Pos, OpARM64SRAconst, x.Type)
+		v0.AuxInt = d
+		v0.AddArg(x)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (CMNshiftRA x (MOVDconst [c]) [d])
+	// cond:
+	// result: (CMNconst x [c>>uint64(d)])
+	for {
+		d := v.AuxInt
+		_ = v.Args[1]
+		x := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpARM64MOVDconst {
+			break
+		}
+		c := v_1.AuxInt
+		v.reset(OpARM64CMNconst)
+		v.AuxInt = c >> uint64(d)
+		v.AddArg(x)
+		return true
+	}
+	return false
+}
+func rewriteValueARM64_OpARM64CMNshiftRL_0(v *Value) bool {
+	b := v.Block
+	_ = b
+	// match: (CMNshiftRL (MOVDconst [c]) x [d])
+	// cond:
+	// result: (CMNconst [c] (SRLconst <x.Type> x [d]))
+	for {
+		d := v.AuxInt
+		_ = v.Args[1]
+		v_0 := v.Args[0]
+		if v_0.Op != OpARM64MOVDconst {
+			break
+		}
+		c := v_0.AuxInt
+		x := v.Args[1]
+		v.reset(OpARM64CMNconst)
+		v.AuxInt = c
+		v0 := b.NewValue0(v.Pos, OpARM64SRLconst, x.Type)
+		v0.AuxInt = d
+		v0.AddArg(x)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (CMNshiftRL x (MOVDconst [c]) [d])
+	// cond:
+	// result: (CMNconst x [int64(uint64(c)>>uint64(d))])
+	for {
+		d := v.AuxInt
+		_ = v.Args[1]
+		x := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpARM64MOVDconst {
+			break
+		}
+		c := v_1.AuxInt
+		v.reset(OpARM64CMNconst)
+		v.AuxInt = int64(uint64(c) >> uint64(d))
+		v.AddArg(x)
+		return true
+	}
+	return false
+}
 func rewriteValueARM64_OpARM64CMP_0(v *Value) bool {
 	b := v.Block
 	_ = b
@@ -2008,7 +2492,7 @@ func rewriteValueARM64_OpARM64CMP_0(v *Value) bool {
 		return true
 	}
 	// match: (CMP x0 x1:(SLLconst [c] y))
-	// cond: clobberIfDead(x1)
+	// cond: x1.Uses == 1 && clobber(x1)
 	// result: (CMPshiftLL x0 y [c])
 	for {
 		_ = v.Args[1]
@@ -2019,7 +2503,7 @@ func rewriteValueARM64_OpARM64CMP_0(v *Value) bool {
 		}
 		c := x1.AuxInt
 		y := x1.Args[0]
-		if !(clobberIfDead(x1)) {
+		if !(x1.Uses == 1 && clobber(x1)) {
 			break
 		}
 		v.reset(OpARM64CMPshiftLL)
@@ -2029,7 +2513,7 @@ func rewriteValueARM64_OpARM64CMP_0(v *Value) bool {
 		return true
 	}
 	// match: (CMP x0:(SLLconst [c] y) x1)
-	// cond: clobberIfDead(x0)
+	// cond: x0.Uses == 1 && clobber(x0)
 	// result: (InvertFlags (CMPshiftLL x1 y [c]))
 	for {
 		_ = v.Args[1]
@@ -2040,7 +2524,7 @@ func rewriteValueARM64_OpARM64CMP_0(v *Value) bool {
 		c := x0.AuxInt
 		y := x0.Args[0]
 		x1 := v.Args[1]
-		if !(clobberIfDead(x0)) {
+		if !(x0.Uses == 1 && clobber(x0)) {
 			break
 		}
 		v.reset(OpARM64InvertFlags)
@@ -2052,7 +2536,7 @@ func rewriteValueARM64_OpARM64CMP_0(v *Value) bool {
 		return true
 	}
 	// match: (CMP x0 x1:(SRLconst [c] y))
-	// cond: clobberIfDead(x1)
+	// cond: x1.Uses == 1 && clobber(x1)
 	// result: (CMPshiftRL x0 y [c])
 	for {
 		_ = v.Args[1]
@@ -2063,7 +2547,7 @@ func rewriteValueARM64_OpARM64CMP_0(v *Value) bool {
 		}
 		c := x1.AuxInt
 		y := x1.Args[0]
-		if !(clobberIfDead(x1)) {
+		if !(x1.Uses == 1 && clobber(x1)) {
 			break
 		}
 		v.reset(OpARM64CMPshiftRL)
@@ -2073,7 +2557,7 @@ func rewriteValueARM64_OpARM64CMP_0(v *Value) bool {
 		return true
 	}
 	// match: (CMP x0:(SRLconst [c] y) x1)
-	// cond: clobberIfDead(x0)
+	// cond: x0.Uses == 1 && clobber(x0)
 	// result: (InvertFlags (CMPshiftRL x1 y [c]))
 	for {
 		_ = v.Args[1]
@@ -2084,7 +2568,7 @@ func rewriteValueARM64_OpARM64CMP_0(v *Value) bool {
 		c := x0.AuxInt
 		y := x0.Args[0]
 		x1 := v.Args[1]
-		if !(clobberIfDead(x0)) {
+		if !(x0.Uses == 1 && clobber(x0)) {
 			break
 		}
 		v.reset(OpARM64InvertFlags)
@@ -2096,7 +2580,7 @@ func rewriteValueARM64_OpARM64CMP_0(v *Value) bool {
 		return true
 	}
 	// match: (CMP x0 x1:(SRAconst [c] y))
-	// cond: clobberIfDead(x1)
+	// cond: x1.Uses == 1 && clobber(x1)
 	// result: (CMPshiftRA x0 y [c])
 	for {
 		_ = v.Args[1]
@@ -2107,7 +2591,7 @@ func rewriteValueARM64_OpARM64CMP_0(v *Value) bool {
 		}
 		c := x1.AuxInt
 		y := x1.Args[0]
-		if !(clobberIfDead(x1)) {
+		if !(x1.Uses == 1 && clobber(x1)) {
 			break
 		}
 		v.reset(OpARM64CMPshiftRA)
@@ -2117,7 +2601,7 @@ func rewriteValueARM64_OpARM64CMP_0(v *Value) bool {
 		return true
 	}
 	// match: (CMP x0:(SRAconst [c] y) x1)
-	// cond: clobberIfDead(x0)
+	// cond: x0.Uses == 1 && clobber(x0)
 	// result: (InvertFlags (CMPshiftRA x1 y [c]))
 	for {
 		_ = v.Args[1]
@@ -2128,7 +2612,7 @@ func rewriteValueARM64_OpARM64CMP_0(v *Value) bool {
 		c := x0.AuxInt
 		y := x0.Args[0]
 		x1 := v.Args[1]
-		if !(clobberIfDead(x0)) {
+		if !(x0.Uses == 1 && clobber(x0)) {
 			break
 		}
 		v.reset(OpARM64InvertFlags)
@@ -2628,6 +3112,56 @@ func rewriteValueARM64_OpARM64CSEL_0(v *Value) bool {
 		v.AddArg(flag)
 		return true
 	}
+	// match: (CSEL {cc} x (ADDconst [1] x) flag)
+	// cond:
+	// result: (CSINC {cc} x x flag)
+	for {
+		cc := v.Aux
+		_ = v.Args[2]
+		x := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpARM64ADDconst {
+			break
+		}
+		if v_1.AuxInt != 1 {
+			break
+		}
+		if x != v_1.Args[0] {
+			break
+		}
+		flag := v.Args[2]
+		v.reset(OpARM64CSINC)
+		v.Aux = cc
+		v.AddArg(x)
+		v.AddArg(x)
+		v.AddArg(flag)
+		return true
+	}
+	// match: (CSEL {cc} (ADDconst [1] x) x flag)
+	// cond:
+	// result: (CSINC {arm64Negate(cc.(Op))} x x flag)
+	for {
+		cc := v.Aux
+		_ = v.Args[2]
+		v_0 := v.Args[0]
+		if v_0.Op != OpARM64ADDconst {
+			break
+		}
+		if v_0.AuxInt != 1 {
+			break
+		}
+		x := v_0.Args[0]
+		if x != v.Args[1] {
+			break
+		}
+		flag := v.Args[2]
+		v.reset(OpARM64CSINC)
+		v.Aux = arm64Negate(cc.(Op))
+		v.AddArg(x)
+		v.AddArg(x)
+		v.AddArg(flag)
+		return true
+	}
 	// match: (CSEL {cc} x y (InvertFlags cmp))
 	// cond:
 	// result: (CSEL {arm64Invert(cc.(Op))} x y cmp)
@@ -2825,10 +3359,65 @@ func rewriteValueARM64_OpARM64CSEL0_0(v *Value) bool {
 		if !(cc.(Op) == OpARM64Equal && flagArg(bool) != nil) {
 			break
 		}
-		v.reset(OpARM64CSEL0)
-		v.Aux = arm64Negate(bool.Op)
-		v.AddArg(x)
-		v.AddArg(flagArg(bool))
+		v.reset(OpARM64CSEL0)
+		v.Aux = arm64Negate(bool.Op)
+		v.AddArg(x)
+		v.AddArg(flagArg(bool))
+		return true
+	}
+	return false
+}
+func rewriteValueARM64_OpARM64CSINC_0(v *Value) bool {
+	// match: (CSINC {cc} x y (InvertFlags cmp))
+	// cond:
+	// result: (CSINC {arm64Invert(cc.(Op))} x y cmp)
+	for {
+		cc := v.Aux
+		_ = v.Args[2]
+		x := v.Args[0]
+		y := v.Args[1]
+		v_2 := v.Args[2]
+		if v_2.Op != OpARM64InvertFlags {
+			break
+		}
+		cmp := v_2.Args[0]
+		v.reset(OpARM64CSINC)
+		v.Aux = arm64Invert(cc.(Op))
+		v.AddArg(x)
+		v.AddArg(y)
+		v.AddArg(cmp)
+		return true
+	}
+	// match: (CSINC {cc} x _ flag)
+	// cond: ccARM64Eval(cc, flag) > 0
+	// result: x
+	for {
+		cc := v.Aux
+		_ = v.Args[2]
+		x := v.Args[0]
+		flag := v.Args[2]
+		if !(ccARM64Eval(cc, flag) > 0) {
+			break
+		}
+		v.reset(OpCopy)
+		v.Type = x.Type
+		v.AddArg(x)
+		return true
+	}
+	// match: (CSINC {cc} _ y flag)
+	// cond: ccARM64Eval(cc, flag) < 0
+	// result: (ADDconst [1] y)
+	for {
+		cc := v.Aux
+		_ = v.Args[2]
+		y := v.Args[1]
+		flag := v.Args[2]
+		if !(ccARM64Eval(cc, flag) < 0) {
+			break
+		}
+		v.reset(OpARM64ADDconst)
+		v.AuxInt = 1
+		v.AddArg(y)
 		return true
 	}
 	return false
@@ -3099,6 +3688,8 @@ func rewriteValueARM64_OpARM64EONshiftRL_0(v *Value) bool {
 	return false
 }
 func rewriteValueARM64_OpARM64Equal_0(v *Value) bool {
+	b := v.Block
+	_ = b
 	// match: (Equal (FlagEQ))
 	// cond:
 	// result: (MOVDconst [1])
@@ -3159,6 +3750,56 @@ func rewriteValueARM64_OpARM64Equal_0(v *Value) bool {
 		v.AuxInt = 0
 		return true
 	}
+	// match: (Equal c:(CMP x z:(NEG y)))
+	// cond: c.Uses == 1 && z.Uses == 1 && clobber(c) && clobber(z)
+	// result: (Equal (CMN x y))
+	for {
+		c := v.Args[0]
+		if c.Op != OpARM64CMP {
+			break
+		}
+		_ = c.Args[1]
+		x := c.Args[0]
+		z := c.Args[1]
+		if z.Op != OpARM64NEG {
+			break
+		}
+		y := z.Args[0]
+		if !(c.Uses == 1 && z.Uses == 1 && clobber(c) && clobber(z)) {
+			break
+		}
+		v.reset(OpARM64Equal)
+		v0 := b.NewValue0(v.Pos, OpARM64CMN, types.TypeFlags)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (Equal c:(CMP z:(NEG x) y))
+	// cond: c.Uses == 1 && z.Uses == 1 && clobber(c) && clobber(z)
+	// result: (Equal (CMN x y))
+	for {
+		c := v.Args[0]
+		if c.Op != OpARM64CMP {
+			break
+		}
+		_ = c.Args[1]
+		z := c.Args[0]
+		if z.Op != OpARM64NEG {
+			break
+		}
+		x := z.Args[0]
+		y := c.Args[1]
+		if !(c.Uses == 1 && z.Uses == 1 && clobber(c) && clobber(z)) {
+			break
+		}
+		v.reset(OpARM64Equal)
+		v0 := b.NewValue0(v.Pos, OpARM64CMN, types.TypeFlags)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		return true
+	}
 	// match: (Equal (InvertFlags x))
 	// cond:
 	// result: (Equal x)
@@ -3174,6 +3815,25 @@ func rewriteValueARM64_OpARM64Equal_0(v *Value) bool {
 	}
 	return false
 }
+func rewriteValueARM64_OpARM64FABSD_0(v *Value) bool {
+	// match: (FABSD (FSUBD x y))
+	// cond:
+	// result: (FABDD x y)
+	for {
+		v_0 := v.Args[0]
+		if v_0.Op != OpARM64FSUBD {
+			break
+		}
+		_ = v_0.Args[1]
+		x := v_0.Args[0]
+		y := v_0.Args[1]
+		v.reset(OpARM64FABDD)
+		v.AddArg(x)
+		v.AddArg(y)
+		return true
+	}
+	return false
+}
 func rewriteValueARM64_OpARM64FADDD_0(v *Value) bool {
 	// match: (FADDD a (FMULD x y))
 	// cond:
@@ -10120,6 +10780,8 @@ func rewriteValueARM64_OpARM64NEG_0(v *Value) bool {
 	return false
 }
 func rewriteValueARM64_OpARM64NotEqual_0(v *Value) bool {
+	b := v.Block
+	_ = b
 	// match: (NotEqual (FlagEQ))
 	// cond:
 	// result: (MOVDconst [0])
@@ -10180,6 +10842,56 @@ func rewriteValueARM64_OpARM64NotEqual_0(v *Value) bool {
 		v.AuxInt = 1
 		return true
 	}
+	// match: (NotEqual c:(CMP x z:(NEG y)))
+	// cond: c.Uses == 1 && z.Uses == 1 && clobber(c) && clobber(z)
+	// result: (NotEqual (CMN x y))
+	for {
+		c := v.Args[0]
+		if c.Op != OpARM64CMP {
+			break
+		}
+		_ = c.Args[1]
+		x := c.Args[0]
+		z := c.Args[1]
+		if z.Op != OpARM64NEG {
+			break
+		}
+		y := z.Args[0]
+		if !(c.Uses == 1 && z.Uses == 1 && clobber(c) && clobber(z)) {
+			break
+		}
+		v.reset(OpARM64NotEqual)
+		v0 := b.NewValue0(v.Pos, OpARM64CMN, types.TypeFlags)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (NotEqual c:(CMP z:(NEG x) y))
+	// cond: c.Uses == 1 && z.Uses == 1 && clobber(c) && clobber(z)
+	// result: (NotEqual (CMN x y))
+	for {
+		c := v.Args[0]
+		if c.Op != OpARM64CMP {
+			break
+		}
+		_ = c.Args[1]
+		z := c.Args[0]
+		if z.Op != OpARM64NEG {
+			break
+		}
+		x := z.Args[0]
+		y := c.Args[1]
+		if !(c.Uses == 1 && z.Uses == 1 && clobber(c) && clobber(z)) {
+			break
+		}
+		v.reset(OpARM64NotEqual)
+		v0 := b.NewValue0(v.Pos, OpARM64CMN, types.TypeFlags)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		return true
+	}
 	// match: (NotEqual (InvertFlags x))
 	// cond:
 	// result: (NotEqual x)
@@ -13156,6 +13868,94 @@ func rewriteValueARM64_OpARM64SUB_0(v *Value) bool {
 		v.AddArg(x)
 		return true
 	}
+	// match: (SUB a l:(MUL x y))
+	// cond: l.Uses==1 && x.Op != OpARM64MOVDconst && y.Op != OpARM64MOVDconst && clobber(l)
+	// result: (MSUB a x y)
+	for {
+		_ = v.Args[1]
+		a := v.Args[0]
+		l := v.Args[1]
+		if l.Op != OpARM64MUL {
+			break
+		}
+		_ = l.Args[1]
+		x := l.Args[0]
+		y := l.Args[1]
+		if !(l.Uses == 1 && x.Op != OpARM64MOVDconst && y.Op != OpARM64MOVDconst && clobber(l)) {
+			break
+		}
+		v.reset(OpARM64MSUB)
+		v.AddArg(a)
+		v.AddArg(x)
+		v.AddArg(y)
+		return true
+	}
+	// match: (SUB a l:(MNEG x y))
+	// cond: l.Uses==1 && x.Op != OpARM64MOVDconst && y.Op != OpARM64MOVDconst && clobber(l)
+	// result: (MADD a x y)
+	for {
+		_ = v.Args[1]
+		a := v.Args[0]
+		l := v.Args[1]
+		if l.Op != OpARM64MNEG {
+			break
+		}
+		_ = l.Args[1]
+		x := l.Args[0]
+		y := l.Args[1]
+		if !(l.Uses == 1 && x.Op != OpARM64MOVDconst && y.Op != OpARM64MOVDconst && clobber(l)) {
+			break
+		}
+		v.reset(OpARM64MADD)
+		v.AddArg(a)
+		v.AddArg(x)
+		v.AddArg(y)
+		return true
+	}
+	// match: (SUB a l:(MULW x y))
+	// cond: a.Type.Size() != 8 && l.Uses==1 && x.Op != OpARM64MOVDconst && y.Op != OpARM64MOVDconst && clobber(l)
+	// result: (MSUBW a x y)
+	for {
+		_ = v.Args[1]
+		a := v.Args[0]
+		l := v.Args[1]
+		if l.Op != OpARM64MULW {
+			break
+		}
+		_ = l.Args[1]
+		x := l.Args[0]
+		y := l.Args[1]
+		if !(a.Type.Size() != 8 && l.Uses == 1 && x.Op != OpARM64MOVDconst && y.Op != OpARM64MOVDconst && clobber(l)) {
+			break
+		}
+		v.reset(OpARM64MSUBW)
+		v.AddArg(a)
+		v.AddArg(x)
+		v.AddArg(y)
+		return true
+	}
+	// match: (SUB a l:(MNEGW x y))
+	// cond: a.Type.Size() != 8 && l.Uses==1 && x.Op != OpARM64MOVDconst && y.Op != OpARM64MOVDconst && clobber(l)
+	// result: (MADDW a x y)
+	for {
+		_ = v.Args[1]
+		a := v.Args[0]
+		l := v.Args[1]
+		if l.Op != OpARM64MNEGW {
+			break
+		}
+		_ = l.Args[1]
+		x := l.Args[0]
+		y := l.Args[1]
+		if !(a.Type.Size() != 8 && l.Uses == 1 && x.Op != OpARM64MOVDconst && y.Op != OpARM64MOVDconst && clobber(l)) {
+			break
+		}
+		v.reset(OpARM64MADDW)
+		v.AddArg(a)
+		v.AddArg(x)
+		v.AddArg(y)
+		return true
+	}
 	// match: (SUB x x)
 	// cond:
 	// result: (MOVDconst [0])
@@ -13253,6 +14053,9 @@ func rewriteValueARM64_OpARM64SUB_0(v *Value) bool {
 		v.AddArg(y)
 		return true
 	}
+	return false
+}
+func rewriteValueARM64_OpARM64SUB_10(v *Value) bool {
 	// match: (SUB x0 x1:(SRAconst [c] y))
 	// cond: clobberIfDead(x1)
 	// result: (SUBshiftRA x0 y [c])
@@ -14261,6 +15064,17 @@ func rewriteValueARM64_OpARM64XORshiftRL_0(v *Value) bool {
 	}
 	return false
 }
+func rewriteValueARM64_OpAbs_0(v *Value) bool {
+	// match: (Abs x)
+	// cond:
+	// result: (FABSD x)
+	for {
+		x := v.Args[0]
+		v.reset(OpARM64FABSD)
+		v.AddArg(x)
+		return true
+	}
+}
 func rewriteValueARM64_OpAdd16_0(v *Value) bool {
 	// match: (Add16 x y)
 	// cond:
@@ -15021,6 +15835,17 @@ func rewriteValueARM64_OpCtz32_0(v *Value) bool {
 		return true
 	}
 }
+func rewriteValueARM64_OpCtz32NonZero_0(v *Value) bool {
+	// match: (Ctz32NonZero x)
+	// cond:
+	// result: (Ctz32 x)
+	for {
+		x := v.Args[0]
+		v.reset(OpCtz32)
+		v.AddArg(x)
+		return true
+	}
+}
 func rewriteValueARM64_OpCtz64_0(v *Value) bool {
 	b := v.Block
 	_ = b
@@ -15037,6 +15862,17 @@ func rewriteValueARM64_OpCtz64_0(v *Value) bool {
 		return true
 	}
 }
+func rewriteValueARM64_OpCtz64NonZero_0(v *Value) bool {
+	// match: (Ctz64NonZero x)
+	// cond:
+	// result: (Ctz64 x)
+	for {
+		x := v.Args[0]
+		v.reset(OpCtz64)
+		v.AddArg(x)
+		return true
+	}
+}
 func rewriteValueARM64_OpCvt32Fto32_0(v *Value) bool {
 	// match: (Cvt32Fto32 x)
 	// cond:
@@ -17223,20 +18059,29 @@ func rewriteValueARM64_OpMod16_0(v *Value) bool {
 	_ = b
 	typ := &b.Func.Config.Types
 	_ = typ
-	// match: (Mod16 x y)
+	// match: (Mod16 <t> x y)
 	// cond:
-	// result: (MODW (SignExt16to32 x) (SignExt16to32 y))
+	// result: (MSUBW (SignExt16to32 x) (SignExt16to32 y) (DIVW <t> (SignExt16to32 x) (SignExt16to32 y)))
 	for {
+		t := v.Type
 		_ = v.Args[1]
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpARM64MODW)
+		v.reset(OpARM64MSUBW)
 		v0 := b.NewValue0(v.Pos, OpSignExt16to32, typ.Int32)
 		v0.AddArg(x)
 		v.AddArg(v0)
 		v1 := b.NewValue0(v.Pos, OpSignExt16to32, typ.Int32)
 		v1.AddArg(y)
 		v.AddArg(v1)
+		v2 := b.NewValue0(v.Pos, OpARM64DIVW, t)
+		v3 := b.NewValue0(v.Pos, OpSignExt16to32, typ.Int32)
+		v3.AddArg(x)
+		v2.AddArg(v3)
+		v4 := b.NewValue0(v.Pos, OpSignExt16to32, typ.Int32)
+		v4.AddArg(y)
+		v2.AddArg(v4)
+		v.AddArg(v2)
 		return true
 	}
 }
@@ -17245,76 +18090,113 @@ func rewriteValueARM64_OpMod16u_0(v *Value) bool {
 	_ = b
 	typ := &b.Func.Config.Types
 	_ = typ
-	// match: (Mod16u x y)
+	// match: (Mod16u <t> x y)
 	// cond:
-	// result: (UMODW (ZeroExt16to32 x) (ZeroExt16to32 y))
+	// result: (MSUBW (ZeroExt16to32 x) (ZeroExt16to32 y) (UDIVW <t> (ZeroExt16to32 x) (ZeroExt16to32 y)))
 	for {
+		t := v.Type
 		_ = v.Args[1]
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpARM64UMODW)
+		v.reset(OpARM64MSUBW)
 		v0 := b.NewValue0(v.Pos, OpZeroExt16to32, typ.UInt32)
 		v0.AddArg(x)
 		v.AddArg(v0)
 		v1 := b.NewValue0(v.Pos, OpZeroExt16to32, typ.UInt32)
 		v1.AddArg(y)
 		v.AddArg(v1)
+		v2 := b.NewValue0(v.Pos, OpARM64UDIVW, t)
+		v3 := b.NewValue0(v.Pos, OpZeroExt16to32, typ.UInt32)
+		v3.AddArg(x)
+		v2.AddArg(v3)
+		v4 := b.NewValue0(v.Pos, OpZeroExt16to32, typ.UInt32)
+		v4.AddArg(y)
+		v2.AddArg(v4)
+		v.AddArg(v2)
 		return true
 	}
 }
 func rewriteValueARM64_OpMod32_0(v *Value) bool {
-	// match: (Mod32 x y)
+	b := v.Block
+	_ = b
+	// match: (Mod32 <t> x y)
 	// cond:
-	// result: (MODW x y)
+	// result: (MSUBW x y (DIVW <t> x y))
 	for {
+		t := v.Type
 		_ = v.Args[1]
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpARM64MODW)
+		v.reset(OpARM64MSUBW)
 		v.AddArg(x)
 		v.AddArg(y)
+		v0 := b.NewValue0(v.Pos, OpARM64DIVW, t)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
 		return true
 	}
 }
 func rewriteValueARM64_OpMod32u_0(v *Value) bool {
-	// match: (Mod32u x y)
+	b := v.Block
+	_ = b
+	// match: (Mod32u <t> x y)
 	// cond:
-	// result: (UMODW x y)
+	// result: (MSUBW x y (UDIVW <t> x y))
 	for {
+		t := v.Type
 		_ = v.Args[1]
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpARM64UMODW)
+		v.reset(OpARM64MSUBW)
 		v.AddArg(x)
 		v.AddArg(y)
+		v0 := b.NewValue0(v.Pos, OpARM64UDIVW, t)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
 		return true
 	}
 }
 func rewriteValueARM64_OpMod64_0(v *Value) bool {
-	// match: (Mod64 x y)
+	b := v.Block
+	_ = b
+	// match: (Mod64 <t> x y)
 	// cond:
-	// result: (MOD x y)
+	// result: (MSUB x y (DIV <t> x y))
 	for {
+		t := v.Type
 		_ = v.Args[1]
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpARM64MOD)
+		v.reset(OpARM64MSUB)
 		v.AddArg(x)
 		v.AddArg(y)
+		v0 := b.NewValue0(v.Pos, OpARM64DIV, t)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
 		return true
 	}
 }
 func rewriteValueARM64_OpMod64u_0(v *Value) bool {
-	// match: (Mod64u x y)
+	b := v.Block
+	_ = b
+	// match: (Mod64u <t> x y)
 	// cond:
-	// result: (UMOD x y)
+	// result: (MSUB x y (UDIV <t> x y))
 	for {
+		t := v.Type
 		_ = v.Args[1]
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpARM64UMOD)
+		v.reset(OpARM64MSUB)
 		v.AddArg(x)
 		v.AddArg(y)
+		v0 := b.NewValue0(v.Pos, OpARM64UDIV, t)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
 		return true
 	}
 }
@@ -17323,20 +18205,29 @@ func rewriteValueARM64_OpMod8_0(v *Value) bool {
 	_ = b
 	typ := &b.Func.Config.Types
 	_ = typ
-	// match: (Mod8 x y)
+	// match: (Mod8 <t> x y)
 	// cond:
-	// result: (MODW (SignExt8to32 x) (SignExt8to32 y))
+	// result: (MSUBW (SignExt8to32 x) (SignExt8to32 y) (DIVW <t> (SignExt8to32 x) (SignExt8to32 y)))
 	for {
+		t := v.Type
 		_ = v.Args[1]
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpARM64MODW)
+		v.reset(OpARM64MSUBW)
 		v0 := b.NewValue0(v.Pos, OpSignExt8to32, typ.Int32)
 		v0.AddArg(x)
 		v.AddArg(v0)
 		v1 := b.NewValue0(v.Pos, OpSignExt8to32, typ.Int32)
 		v1.AddArg(y)
 		v.AddArg(v1)
+		v2 := b.NewValue0(v.Pos, OpARM64DIVW, t)
+		v3 := b.NewValue0(v.Pos, OpSignExt8to32, typ.Int32)
+		v3.AddArg(x)
+		v2.AddArg(v3)
+		v4 := b.NewValue0(v.Pos, OpSignExt8to32, typ.Int32)
+		v4.AddArg(y)
+		v2.AddArg(v4)
+		v.AddArg(v2)
 		return true
 	}
 }
@@ -17345,20 +18236,29 @@ func rewriteValueARM64_OpMod8u_0(v *Value) bool {
 	_ = b
 	typ := &b.Func.Config.Types
 	_ = typ
-	// match: (Mod8u x y)
+	// match: (Mod8u <t> x y)
 	// cond:
-	// result: (UMODW (ZeroExt8to32 x) (ZeroExt8to32 y))
+	// result: (MSUBW (ZeroExt8to32 x) (ZeroExt8to32 y) (UDIVW <t> (ZeroExt8to32 x) (ZeroExt8to32 y)))
 	for {
+		t := v.Type
 		_ = v.Args[1]
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpARM64UMODW)
+		v.reset(OpARM64MSUBW)
 		v0 := b.NewValue0(v.Pos, OpZeroExt8to32, typ.UInt32)
 		v0.AddArg(x)
 		v.AddArg(v0)
 		v1 := b.NewValue0(v.Pos, OpZeroExt8to32, typ.UInt32)
 		v1.AddArg(y)
 		v.AddArg(v1)
+		v2 := b.NewValue0(v.Pos, OpARM64UDIVW, t)
+		v3 := b.NewValue0(v.Pos, OpZeroExt8to32, typ.UInt32)
+		v3.AddArg(x)
+		v2.AddArg(v3)
+		v4 := b.NewValue0(v.Pos, OpZeroExt8to32, typ.UInt32)
+		v4.AddArg(y)
+		v2.AddArg(v4)
+		v.AddArg(v2)
 		return true
 	}
 }
@@ -20622,6 +21522,60 @@ func rewriteBlockARM64(b *Block) bool {
 			b.Aux = nil
 			return true
 		}
+		// match: (EQ c:(CMP x z:(NEG y)) yes no)
+		// cond: c.Uses == 1 && z.Uses == 1 && clobber(c) && clobber(z)
+		// result: (EQ (CMN x y) yes no)
+		for {
+			v := b.Control
+			c := b.Control
+			if v.Op != OpARM64CMP {
+				break
+			}
+			_ = v.Args[1]
+			x := v.Args[0]
+			z := v.Args[1]
+			if z.Op != OpARM64NEG {
+				break
+			}
+			y := z.Args[0]
+			if !(c.Uses == 1 && z.Uses == 1 && clobber(c) && clobber(z)) {
+				break
+			}
+			b.Kind = BlockARM64EQ
+			v0 := b.NewValue0(v.Pos, OpARM64CMN, types.TypeFlags)
+			v0.AddArg(x)
+			v0.AddArg(y)
+			b.SetControl(v0)
+			b.Aux = nil
+			return true
+		}
+		// match: (EQ c:(CMP z:(NEG x) y) yes no)
+		// cond: c.Uses == 1 && z.Uses == 1 && clobber(c) && clobber(z)
+		// result: (EQ (CMN x y) yes no)
+		for {
+			v := b.Control
+			c := b.Control
+			if v.Op != OpARM64CMP {
+				break
+			}
+			_ = v.Args[1]
+			z := v.Args[0]
+			if z.Op != OpARM64NEG {
+				break
+			}
+			x := z.Args[0]
+			y := v.Args[1]
+			if !(c.Uses == 1 && z.Uses == 1 && clobber(c) && clobber(z)) {
+				break
+			}
+			b.Kind = BlockARM64EQ
+			v0 := b.NewValue0(v.Pos, OpARM64CMN, types.TypeFlags)
+			v0.AddArg(x)
+			v0.AddArg(y)
+			b.SetControl(v0)
+			b.Aux = nil
+			return true
+		}
 		// match: (EQ (FlagEQ) yes no)
 		// cond:
 		// result: (First nil yes no)
@@ -21291,6 +22245,60 @@ func rewriteBlockARM64(b *Block) bool {
 			b.Aux = nil
 			return true
 		}
+		// match: (NE c:(CMP x z:(NEG y)) yes no)
+		// cond: c.Uses == 1 && z.Uses == 1 && clobber(c) && clobber(z)
+		// result: (NE (CMN x y) yes no)
+		for {
+			v := b.Control
+			c := b.Control
+			if v.Op != OpARM64CMP {
+				break
+			}
+			_ = v.Args[1]
+			x := v.Args[0]
+			z := v.Args[1]
+			if z.Op != OpARM64NEG {
+				break
+			}
+			y := z.Args[0]
+			if !(c.Uses == 1 && z.Uses == 1 && clobber(c) && clobber(z)) {
+				break
+			}
+			b.Kind = BlockARM64NE
+			v0 := b.NewValue0(v.Pos, OpARM64CMN, types.TypeFlags)
+			v0.AddArg(x)
+			v0.AddArg(y)
+			b.SetControl(v0)
+			b.Aux = nil
+			return true
+		}
+		// match: (NE c:(CMP z:(NEG x) y) yes no)
+		// cond: c.Uses == 1 && z.Uses == 1 && clobber(c) && clobber(z)
+		// result: (NE (CMN x y) yes no)
+		for {
+			v := b.Control
+			c := b.Control
+			if v.Op != OpARM64CMP {
+				break
+			}
+			_ = v.Args[1]
+			z := v.Args[0]
+			if z.Op != OpARM64NEG {
+				break
+			}
+			x := z.Args[0]
+			y := v.Args[1]
+			if !(c.Uses == 1 && z.Uses == 1 && clobber(c) && clobber(z)) {
+				break
+			}
+			b.Kind = BlockARM64NE
+			v0 := b.NewValue0(v.Pos, OpARM64CMN, types.TypeFlags)
+			v0.AddArg(x)
+			v0.AddArg(y)
+			b.SetControl(v0)
+			b.Aux = nil
+			return true
+		}
 		// match: (NE (FlagEQ) yes no)
 		// cond:
 		// result: (First nil no yes)
@@ -75,6 +75,10 @@ func rewriteValuePPC64(v *Value) bool {
 		return rewriteValuePPC64_OpBitLen32_0(v)
 	case OpBitLen64:
 		return rewriteValuePPC64_OpBitLen64_0(v)
+	case OpBswap32:
+		return rewriteValuePPC64_OpBswap32_0(v)
+	case OpBswap64:
+		return rewriteValuePPC64_OpBswap64_0(v)
 	case OpCeil:
 		return rewriteValuePPC64_OpCeil_0(v)
 	case OpClosureCall:
@@ -109,8 +113,12 @@ func rewriteValuePPC64(v *Value) bool {
 		return rewriteValuePPC64_OpCopysign_0(v)
 	case OpCtz32:
 		return rewriteValuePPC64_OpCtz32_0(v)
+	case OpCtz32NonZero:
+		return rewriteValuePPC64_OpCtz32NonZero_0(v)
 	case OpCtz64:
 		return rewriteValuePPC64_OpCtz64_0(v)
+	case OpCtz64NonZero:
+		return rewriteValuePPC64_OpCtz64NonZero_0(v)
 	case OpCvt32Fto32:
 		return rewriteValuePPC64_OpCvt32Fto32_0(v)
 	case OpCvt32Fto64:
@@ -385,6 +393,10 @@ func rewriteValuePPC64(v *Value) bool {
 		return rewriteValuePPC64_OpPPC64AND_0(v)
 	case OpPPC64ANDconst:
 		return rewriteValuePPC64_OpPPC64ANDconst_0(v)
+	case OpPPC64BSWAP32:
+		return rewriteValuePPC64_OpPPC64BSWAP32_0(v)
+	case OpPPC64BSWAP64:
+		return rewriteValuePPC64_OpPPC64BSWAP64_0(v)
 	case OpPPC64CMP:
 		return rewriteValuePPC64_OpPPC64CMP_0(v)
 	case OpPPC64CMPU:
@@ -1111,6 +1123,28 @@ func rewriteValuePPC64_OpBitLen64_0(v *Value) bool {
 		return true
 	}
 }
+func rewriteValuePPC64_OpBswap32_0(v *Value) bool {
+	// match: (Bswap32 x)
+	// cond:
+	// result: (BSWAP32 x)
+	for {
+		x := v.Args[0]
+		v.reset(OpPPC64BSWAP32)
+		v.AddArg(x)
+		return true
+	}
+}
+func rewriteValuePPC64_OpBswap64_0(v *Value) bool {
+	// match: (Bswap64 x)
+	// cond:
+	// result: (BSWAP64 x)
+	for {
+		x := v.Args[0]
+		v.reset(OpPPC64BSWAP64)
+		v.AddArg(x)
+		return true
+	}
+}
 func rewriteValuePPC64_OpCeil_0(v *Value) bool {
 	// match: (Ceil x)
 	// cond:
@@ -1328,6 +1362,17 @@ func rewriteValuePPC64_OpCtz32_0(v *Value) bool {
 		return true
 	}
 }
+func rewriteValuePPC64_OpCtz32NonZero_0(v *Value) bool {
+	// match: (Ctz32NonZero x)
+	// cond:
+	// result: (Ctz32 x)
+	for {
+		x := v.Args[0]
+		v.reset(OpCtz32)
+		v.AddArg(x)
+		return true
+	}
+}
 func rewriteValuePPC64_OpCtz64_0(v *Value) bool {
 	b := v.Block
 	_ = b
@@ -1349,6 +1394,17 @@ func rewriteValuePPC64_OpCtz64_0(v *Value) bool {
 		return true
 	}
 }
+func rewriteValuePPC64_OpCtz64NonZero_0(v *Value) bool {
+	// match: (Ctz64NonZero x)
+	// cond:
+	// result: (Ctz64 x)
+	for {
+		x := v.Args[0]
+		v.reset(OpCtz64)
+		v.AddArg(x)
+		return true
+	}
+}
 func rewriteValuePPC64_OpCvt32Fto32_0(v *Value) bool {
 	b := v.Block
 	_ = b
@@ -5713,6 +5769,130 @@ func rewriteValuePPC64_OpPPC64ANDconst_0(v *Value) bool {
 	}
 	return false
 }
+func rewriteValuePPC64_OpPPC64BSWAP32_0(v *Value) bool {
+	b := v.Block
+	_ = b
+	typ := &b.Func.Config.Types
+	_ = typ
+	// match: (BSWAP32 x:(MOVWZload [off] {sym} ptr mem))
+	// cond: x.Uses == 1 && sym == nil && clobber(x)
+	// result: @x.Block (MOVWBRload (ADDconst <ptr.Type> [off] ptr) mem)
+	for {
+		x := v.Args[0]
+		if x.Op != OpPPC64MOVWZload {
+			break
+		}
+		off := x.AuxInt
+		sym := x.Aux
+		_ = x.Args[1]
+		ptr := x.Args[0]
+		mem := x.Args[1]
+		if !(x.Uses == 1 && sym == nil && clobber(x)) {
+			break
+		}
+		b = x.Block
+		v0 := b.NewValue0(v.Pos, OpPPC64MOVWBRload, typ.UInt32)
+		v.reset(OpCopy)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpPPC64ADDconst, ptr.Type)
+		v1.AuxInt = off
+		v1.AddArg(ptr)
+		v0.AddArg(v1)
+		v0.AddArg(mem)
+		return true
+	}
+	// match: (BSWAP32 x:(MOVWZload [off] {sym} ptr mem))
+	// cond: x.Uses == 1 && sym != nil && (ptr.Op == OpSP || ptr.Op == OpSB) && clobber(x)
+	// result: @x.Block (MOVWBRload (MOVDaddr <ptr.Type> [off] {sym} ptr) mem)
+	for {
+		x := v.Args[0]
+		if x.Op != OpPPC64MOVWZload {
+			break
+		}
+		off := x.AuxInt
+		sym := x.Aux
+		_ = x.Args[1]
+		ptr := x.Args[0]
+		mem := x.Args[1]
+		if !(x.Uses == 1 && sym != nil && (ptr.Op == OpSP || ptr.Op == OpSB) && clobber(x)) {
+			break
+		}
+		b = x.Block
+		v0 := b.NewValue0(v.Pos, OpPPC64MOVWBRload, typ.UInt32)
+		v.reset(OpCopy)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpPPC64MOVDaddr, ptr.Type)
+		v1.AuxInt = off
+		v1.Aux = sym
+		v1.AddArg(ptr)
+		v0.AddArg(v1)
+		v0.AddArg(mem)
+		return true
+	}
+	return false
+}
+func rewriteValuePPC64_OpPPC64BSWAP64_0(v *Value) bool {
+	b := v.Block
+	_ = b
+	typ := &b.Func.Config.Types
+	_ = typ
+	// match: (BSWAP64 x:(MOVDload [off] {sym} ptr mem))
+	// cond: x.Uses == 1 && sym == nil && clobber(x)
+	// result: @x.Block (MOVDBRload (ADDconst <ptr.Type> [off] ptr) mem)
+	for {
+		x := v.Args[0]
+		if x.Op != OpPPC64MOVDload {
+			break
+		}
+		off := x.AuxInt
+		sym := x.Aux
+		_ = x.Args[1]
+		ptr := x.Args[0]
+		mem := x.Args[1]
+		if !(x.Uses == 1 && sym == nil && clobber(x)) {
+			break
+		}
+		b = x.Block
+		v0 := b.NewValue0(v.Pos, OpPPC64MOVDBRload, typ.Int64)
+		v.reset(OpCopy)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpPPC64ADDconst, ptr.Type)
+		v1.AuxInt = off
+		v1.AddArg(ptr)
+		v0.AddArg(v1)
+		v0.AddArg(mem)
+		return true
+	}
+	// match: (BSWAP64 x:(MOVDload [off] {sym} ptr mem))
+	// cond: x.Uses == 1 && sym != nil && (ptr.Op == OpSP || ptr.Op == OpSB) && clobber(x)
+	// result: @x.Block (MOVDBRload (MOVDaddr <ptr.Type> [off] {sym} ptr) mem)
+	for {
+		x := v.Args[0]
+		if x.Op != OpPPC64MOVDload {
+			break
+		}
+		off := x.AuxInt
+		sym := x.Aux
+		_ = x.Args[1]
+		ptr := x.Args[0]
+		mem := x.Args[1]
+		if !(x.Uses == 1 && sym != nil && (ptr.Op == OpSP || ptr.Op == OpSB) && clobber(x)) {
+			break
+		}
+		b = x.Block
+		v0 := b.NewValue0(v.Pos, OpPPC64MOVDBRload, typ.Int64)
+		v.reset(OpCopy)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpPPC64MOVDaddr, ptr.Type)
+		v1.AuxInt = off
+		v1.Aux = sym
+		v1.AddArg(ptr)
+		v0.AddArg(v1)
+		v0.AddArg(mem)
+		return true
+	}
+	return false
+}
 func rewriteValuePPC64_OpPPC64CMP_0(v *Value) bool {
 	b := v.Block
 	_ = b
@@ -7720,6 +7900,8 @@ func rewriteValuePPC64_OpPPC64MOVDload_0(v *Value) bool {
 	return false
 }
 func rewriteValuePPC64_OpPPC64MOVDstore_0(v *Value) bool {
+	b := v.Block
+	_ = b
 	// match: (MOVDstore [off] {sym} ptr (MFVSRD x) mem)
 	// cond:
 	// result: (FMOVDstore [off] {sym} ptr x mem)
@@ -7795,6 +7977,59 @@ func rewriteValuePPC64_OpPPC64MOVDstore_0(v *Value) bool {
 		v.AddArg(mem)
 		return true
 	}
+	// match: (MOVDstore [off] {sym} ptr (BSWAP64 x) mem)
+	// cond: sym == nil
+	// result: (MOVDBRstore (ADDconst <ptr.Type> [off] ptr) x mem)
+	for {
+		off := v.AuxInt
+		sym := v.Aux
+		_ = v.Args[2]
+		ptr := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpPPC64BSWAP64 {
+			break
+		}
+		x := v_1.Args[0]
+		mem := v.Args[2]
+		if !(sym == nil) {
+			break
+		}
+		v.reset(OpPPC64MOVDBRstore)
+		v0 := b.NewValue0(v.Pos, OpPPC64ADDconst, ptr.Type)
+		v0.AuxInt = off
+		v0.AddArg(ptr)
+		v.AddArg(v0)
+		v.AddArg(x)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (MOVDstore [off] {sym} ptr (BSWAP64 x) mem)
+	// cond: sym != nil && (ptr.Op == OpSP || ptr.Op == OpSB)
+	// result: (MOVDBRstore (MOVDaddr <ptr.Type> [off] {sym} ptr) x mem)
+	for {
+		off := v.AuxInt
+		sym := v.Aux
+		_ = v.Args[2]
+		ptr := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpPPC64BSWAP64 {
+			break
+		}
+		x := v_1.Args[0]
+		mem := v.Args[2]
+		if !(sym != nil && (ptr.Op == OpSP || ptr.Op == OpSB)) {
+			break
+		}
+		v.reset(OpPPC64MOVDBRstore)
+		v0 := b.NewValue0(v.Pos, OpPPC64MOVDaddr, ptr.Type)
+		v0.AuxInt = off
+		v0.Aux = sym
+		v0.AddArg(ptr)
+		v.AddArg(v0)
+		v.AddArg(x)
+		v.AddArg(mem)
+		return true
+	}
 	// match: (MOVDstore [off] {sym} ptr (MOVDconst [c]) mem)
 	// cond: c == 0
 	// result: (MOVDstorezero [off] {sym} ptr mem)
@@ -8662,6 +8897,8 @@ func rewriteValuePPC64_OpPPC64MOVWreg_0(v *Value) bool {
 	return false
 }
 func rewriteValuePPC64_OpPPC64MOVWstore_0(v *Value) bool {
+	b := v.Block
+	_ = b
 	// match: (MOVWstore [off1] {sym} (ADDconst [off2] x) val mem)
 	// cond: is16Bit(off1+off2)
 	// result: (MOVWstore [off1+off2] {sym} x val mem)
@@ -8715,6 +8952,59 @@ func rewriteValuePPC64_OpPPC64MOVWstore_0(v *Value) bool {
 		v.AddArg(mem)
 		return true
 	}
+	// match: (MOVWstore [off] {sym} ptr (BSWAP32 x) mem)
+	// cond: sym == nil
+	// result: (MOVWBRstore (ADDconst <ptr.Type> [off] ptr) x mem)
+	for {
+		off := v.AuxInt
+		sym := v.Aux
+		_ = v.Args[2]
+		ptr := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpPPC64BSWAP32 {
+			break
+		}
+		x := v_1.Args[0]
+		mem := v.Args[2]
+		if !(sym == nil) {
+			break
+		}
+		v.reset(OpPPC64MOVWBRstore)
+		v0 := b.NewValue0(v.Pos, OpPPC64ADDconst, ptr.Type)
+		v0.AuxInt = off
+		v0.AddArg(ptr)
+		v.AddArg(v0)
+		v.AddArg(x)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (MOVWstore [off] {sym} ptr (BSWAP32 x) mem)
+	// cond: sym != nil && (ptr.Op == OpSP || ptr.Op == OpSB)
+	// result: (MOVWBRstore (MOVDaddr <ptr.Type> [off] {sym} ptr) x mem)
+	for {
+		off := v.AuxInt
+		sym := v.Aux
+		_ = v.Args[2]
+		ptr := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpPPC64BSWAP32 {
+			break
+		}
+		x := v_1.Args[0]
+		mem := v.Args[2]
+		if !(sym != nil && (ptr.Op == OpSP || ptr.Op == OpSB)) {
+			break
+		}
+		v.reset(OpPPC64MOVWBRstore)
+		v0 := b.NewValue0(v.Pos, OpPPC64MOVDaddr, ptr.Type)
+		v0.AuxInt = off
+		v0.Aux = sym
+		v0.AddArg(ptr)
+		v.AddArg(v0)
+		v.AddArg(x)
+		v.AddArg(mem)
+		return true
+	}
 	// match: (MOVWstore [off] {sym} ptr (MOVDconst [c]) mem)
 	// cond: c == 0
 	// result: (MOVWstorezero [off] {sym} ptr mem)
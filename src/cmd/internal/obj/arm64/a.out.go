@@ -405,10 +405,13 @@ const (
 	C_ADDCON0  // 12-bit unsigned, unshifted
 	C_ABCON    // could be C_ADDCON or C_BITCON
 	C_ADDCON   // 12-bit unsigned, shifted left by 0 or 12
+	C_AMCON    // could be C_ADDCON or C_MOVCON
 	C_MBCON    // could be C_MOVCON or C_BITCON
 	C_MOVCON   // generated by a 16-bit constant, optionally inverted and/or shifted by multiple of 16
 	C_BITCON   // bitfield and logical immediate masks
 	C_LCON     // 32-bit constant
+	C_MOVCON2  // a constant that can be loaded with one MOVZ/MOVN and one MOVK
+	C_MOVCON3  // a constant that can be loaded with one MOVZ/MOVN and two MOVKs
 	C_VCON     // 64-bit constant
 	C_FCON     // floating-point constant
 	C_VCONADDR // 64-bit memory address
@@ -756,6 +759,8 @@ const (
 	ABLT
 	ABGT
 	ABLE
+	AFABDD
+	AFABDS
 	AFABSD
 	AFABSS
 	AFADDD
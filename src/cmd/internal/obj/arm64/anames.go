@@ -270,6 +270,8 @@ var Anames = []string{
 	"BLT",
 	"BGT",
 	"BLE",
+	"FABDD",
+	"FABDS",
 	"FABSD",
 	"FABSS",
 	"FADDD",
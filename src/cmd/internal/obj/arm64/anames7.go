@@ -24,10 +24,13 @@ var cnames7 = []string{
 	"ADDCON0",
 	"ABCON",
 	"ADDCON",
+	"AMCON",
 	"MBCON",
 	"MOVCON",
 	"BITCON",
 	"LCON",
+	"MOVCON2",
+	"MOVCON3",
 	"VCON",
 	"FCON",
 	"VCONADDR",
@@ -286,7 +286,9 @@ var optab = []Optab{
 	{AWORD, C_NONE, C_NONE, C_ADDR, 14, 4, 0, 0, 0},
 	{AMOVW, C_VCON, C_NONE, C_REG, 12, 4, 0, LFROM, 0},
 	{AMOVW, C_VCONADDR, C_NONE, C_REG, 68, 8, 0, 0, 0},
-	{AMOVD, C_VCON, C_NONE, C_REG, 12, 4, 0, LFROM, 0},
+	{AMOVD, C_MOVCON2, C_NONE, C_REG, 12, 8, 0, 0, 0},
+	{AMOVD, C_MOVCON3, C_NONE, C_REG, 12, 12, 0, 0, 0},
+	{AMOVD, C_VCON, C_NONE, C_REG, 12, 16, 0, 0, 0},
 	{AMOVD, C_VCONADDR, C_NONE, C_REG, 68, 8, 0, 0, 0},
 	{AMOVB, C_REG, C_NONE, C_ADDR, 64, 12, 0, 0, 0},
 	{AMOVBU, C_REG, C_NONE, C_ADDR, 64, 12, 0, 0, 0},
@@ -887,7 +889,7 @@ func (c *ctxt7) addpool(p *obj.Prog, a *obj.Addr) {
 
 	/* This is here because MOV uint12<<12, R is disabled in optab.
 	Because of this, we need to load the constant from memory. */
-	case C_ADDCON:
+	case C_ADDCON, C_AMCON:
 		fallthrough
 
 	case C_PSAUTO,
@@ -924,6 +926,8 @@ func (c *ctxt7) addpool(p *obj.Prog, a *obj.Addr) {
 		C_LOREG,
 		C_LACON,
 		C_LCON,
+		C_MOVCON2,
+		C_MOVCON3,
 		C_VCON:
 		if a.Name == obj.NAME_EXTERN {
 			fmt.Printf("addpool: %v in %v needs reloc\n", DRconv(cls), p)
@@ -1225,6 +1229,31 @@ func movcon(v int64) int {
 	return -1
 }
 
+// con64class returns the class of the 64-bit constant v, which is
+// neither a MOVCON nor a BITCON, by the number of instructions
+// omovlconst needs to load it.
+func con64class(v int64) int {
+	zeroCount, negCount := 0, 0
+	for i := uint(0); i < 64; i += 16 {
+		switch uint64(v) >> i & 0xFFFF {
+		case 0:
+			zeroCount++
+		case 0xFFFF:
+			negCount++
+		}
+	}
+	if negCount > zeroCount {
+		zeroCount = negCount
+	}
+	switch zeroCount {
+	case 2:
+		return C_MOVCON2
+	case 1:
+		return C_MOVCON3
+	}
+	return C_VCON
+}
+
 func rclass(r int16) int {
 	switch {
 	case REG_R0 <= r && r <= REG_R30: // not 31
@@ -1347,6 +1376,9 @@ func (c *ctxt7) aclass(a *obj.Addr) int {
 				if isbitcon(uint64(v)) {
 					return C_ABCON
 				}
+				if movcon(v) >= 0 {
+					return C_AMCON
+				}
 				return C_ADDCON
 			}
 
@@ -1373,7 +1405,7 @@ func (c *ctxt7) aclass(a *obj.Addr) int {
 			if uint64(v) == uint64(uint32(v)) || v == int64(int32(v)) {
 				return C_LCON
 			}
-			return C_VCON
+			return con64class(v)
 
 		case obj.NAME_EXTERN, obj.NAME_STATIC:
 			if a.Sym == nil {
@@ -1492,7 +1524,7 @@ func cmp(a int, b int) bool {
 		}
 
 	case C_ADDCON:
-		if b == C_ZCON || b == C_ABCON0 || b == C_ADDCON0 || b == C_ABCON {
+		if b == C_ZCON || b == C_ABCON0 || b == C_ADDCON0 || b == C_ABCON || b == C_AMCON {
 			return true
 		}
 
@@ -1502,18 +1534,24 @@ func cmp(a int, b int) bool {
 		}
 
 	case C_MOVCON:
-		if b == C_MBCON || b == C_ZCON || b == C_ADDCON0 {
+		if b == C_MBCON || b == C_ZCON || b == C_ADDCON0 || b == C_AMCON {
 			return true
 		}
 
 	case C_LCON:
-		if b == C_ZCON || b == C_BITCON || b == C_ADDCON || b == C_ADDCON0 || b == C_ABCON || b == C_ABCON0 || b == C_MBCON || b == C_MOVCON {
+		if b == C_ZCON || b == C_BITCON || b == C_ADDCON || b == C_ADDCON0 || b == C_ABCON || b == C_ABCON0 || b == C_MBCON || b == C_MOVCON || b == C_AMCON {
 			return true
 		}
 
-	case C_VCON:
+	case C_MOVCON2:
 		return cmp(C_LCON, b)
 
+	case C_MOVCON3:
+		return cmp(C_MOVCON2, b)
+
+	case C_VCON:
+		return cmp(C_MOVCON3, b)
+
 	case C_LACON:
 		if b == C_AACON {
 			return true
@@ -1980,6 +2018,8 @@ func buildop(ctxt *obj.Link) {
 
 		case AFADDS:
 			oprangeset(AFADDD, t)
+			oprangeset(AFABDS, t)
+			oprangeset(AFABDD, t)
 			oprangeset(AFSUBS, t)
 			oprangeset(AFSUBD, t)
 			oprangeset(AFMULS, t)
@@ -2411,7 +2451,16 @@ func (c *ctxt7) asmout(p *obj.Prog, o *Optab, out []uint32) {
 		}
 
 	case 12: /* movT $vcon, reg */
-		o1 = c.omovlit(p.As, p, &p.From, int(p.To.Reg))
+		if p.As == AMOVW {
+			o1 = c.omovlit(p.As, p, &p.From, int(p.To.Reg))
+			break
+		}
+		var os [4]uint32
+		num := c.omovlconst(p, &p.From, int(p.To.Reg), os[:])
+		if num*4 != int(o.size) {
+			c.ctxt.Diag("bad constant size %d\n%v", num*4, p)
+		}
+		o1, o2, o3, o4 = os[0], os[1], os[2], os[3]
 
 	case 13: /* addop $vcon, [R], R (64 bit literal); cmp $lcon,R -> addop $lcon,R, ZR */
 		o1 = c.omovlit(AMOVD, p, &p.From, REGTMP)
@@ -3099,7 +3148,7 @@ func (c *ctxt7) asmout(p *obj.Prog, o *Optab, out []uint32) {
 		}
 		rt := int(p.To.Reg)
 		r := int(p.Reg)
-		if (o1&(0x1F<<24)) == (0x1E<<24) && (o1&(1<<11)) == 0 { /* monadic */
+		if (o1&(0x5F<<24)) == (0x1E<<24) && (o1&(1<<11)) == 0 { /* monadic, excluding scalar SIMD (FABD) */
 			r = rf
 			rf = 0
 		} else if r == 0 {
@@ -4333,6 +4382,12 @@ func (c *ctxt7) oprrr(p *obj.Prog, a obj.As) uint32 {
 	case AFNMULD:
 		return FPOP2S(0, 0, 1, 8)
 
+	case AFABDS:
+		return 1<<30 | 1<<29 | 0x1E<<24 | 1<<23 | 0<<22 | 1<<21 | 0x1A<<11 | 1<<10
+
+	case AFABDD:
+		return 1<<30 | 1<<29 | 0x1E<<24 | 1<<23 | 1<<22 | 1<<21 | 0x1A<<11 | 1<<10
+
 	case AFCMPS:
 		return FPCMP(0, 0, 0, 0, 0)
 
@@ -5366,6 +5421,42 @@ func (c *ctxt7) omovconst(as obj.As, p *obj.Prog, a *obj.Addr, rt int) (o1 uint3
 	return o1
 }
 
+// load a 64-bit constant in a into rt with a MOVZ (or MOVN, if more
+// 16-bit chunks are 0xFFFF than 0) followed by MOVKs for the chunks it
+// did not already set, and return the number of instructions written to os.
+func (c *ctxt7) omovlconst(p *obj.Prog, a *obj.Addr, rt int, os []uint32) (num int) {
+	d := uint64(a.Offset)
+	zeroCount, negCount := 0, 0
+	for s := uint(0); s < 64; s += 16 {
+		switch d >> s & 0xFFFF {
+		case 0:
+			zeroCount++
+		case 0xFFFF:
+			negCount++
+		}
+	}
+	as, skip := AMOVZ, uint64(0)
+	if negCount > zeroCount {
+		as, skip = AMOVN, 0xFFFF
+	}
+	for s := uint(0); s < 64; s += 16 {
+		imm := d >> s & 0xFFFF
+		if imm == skip {
+			continue
+		}
+		var o1 uint32
+		if num == 0 {
+			o1 = c.opirr(p, as)
+			imm ^= skip
+		} else {
+			o1 = c.opirr(p, AMOVK)
+		}
+		os[num] = o1 | uint32(imm<<5) | uint32(s/16&3)<<21 | uint32(rt&31)
+		num++
+	}
+	return num
+}
+
 func (c *ctxt7) opbfm(p *obj.Prog, a obj.As, r int, s int, rf int, rt int) uint32 {
 	var b uint32
 	o := c.opirr(p, a)
@@ -745,3 +745,26 @@ func init() {
 		tab[i].pop = n
 	}
 }
+
+func TestMul64(t *testing.T) {
+	for _, a := range []struct {
+		x, y   uint64
+		hi, lo uint64
+	}{
+		{0, 0, 0, 0},
+		{1, 1, 0, 1},
+		{1 << 32, 1 << 32, 1, 0},
+		{1<<64 - 1, 2, 1, 1<<64 - 2},
+		{1<<64 - 1, 1<<64 - 1, 1<<64 - 2, 1},
+		{0x0123456789abcdef, 0xfedcba9876543210, 0x0121fa00ad77d742, 0x2236d88fe5618cf0},
+	} {
+		hi, lo := Mul64(a.x, a.y)
+		if hi != a.hi || lo != a.lo {
+			t.Errorf("Mul64(%#x, %#x) == %#x, %#x; want %#x, %#x", a.x, a.y, hi, lo, a.hi, a.lo)
+		}
+		hi, lo = Mul64(a.y, a.x)
+		if hi != a.hi || lo != a.lo {
+			t.Errorf("Mul64(%#x, %#x) == %#x, %#x; want %#x, %#x", a.y, a.x, hi, lo, a.hi, a.lo)
+		}
+	}
+}
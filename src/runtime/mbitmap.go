@@ -832,6 +832,7 @@ func (h heapBits) clearCheckmarkSpan(size, n, total uintptr) {
 // oneBitCount is indexed by byte and produces the
 // number of 1 bits in that byte. For example 128 has 1 bit set
 // and oneBitCount[128] will holds 1.
+// The compiler loads from it for math/bits.OnesCount8 on amd64.
 var oneBitCount = [256]uint8{
 	0, 1, 1, 2, 1, 2, 2, 3,
 	1, 2, 2, 3, 2, 3, 3, 4,
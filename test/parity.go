@@ -0,0 +1,95 @@
+// run
+
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Test that bits.OnesCount8 and the parity of a byte, which the
+// compiler may compute from the parity flag, keep their meaning.
+
+package main
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+//go:noinline
+func count8(x uint8) int {
+	return bits.OnesCount8(x)
+}
+
+//go:noinline
+func odd8(x uint8) int {
+	return bits.OnesCount8(x) % 2
+}
+
+//go:noinline
+func even8(x uint32) bool {
+	return bits.OnesCount8(uint8(x>>8))&1 == 0
+}
+
+//go:noinline
+func fold8(x uint8) uint8 {
+	x ^= x >> 4
+	x ^= x >> 2
+	x ^= x >> 1
+	return x & 1
+}
+
+//go:noinline
+func fold16(x uint16) uint16 {
+	x ^= x >> 1
+	x ^= x >> 4
+	x ^= x >> 2
+	return x & 1
+}
+
+//go:noinline
+func fold64(x uint64) uint64 {
+	x ^= x >> 32
+	x ^= x >> 16
+	x ^= x >> 8
+	x ^= x >> 4
+	x ^= x >> 2
+	x ^= x >> 1
+	return x & 1
+}
+
+func slowCount(x uint64) int {
+	n := 0
+	for ; x != 0; x >>= 1 {
+		n += int(x & 1)
+	}
+	return n
+}
+
+func main() {
+	for i := 0; i < 256; i++ {
+		x := uint8(i)
+		n := slowCount(uint64(x))
+		if got := count8(x); got != n {
+			panic(fmt.Sprintf("count8(%#x) = %d, want %d", x, got, n))
+		}
+		if got := odd8(x); got != n%2 {
+			panic(fmt.Sprintf("odd8(%#x) = %d, want %d", x, got, n%2))
+		}
+		if got := fold8(x); int(got) != n%2 {
+			panic(fmt.Sprintf("fold8(%#x) = %d, want %d", x, got, n%2))
+		}
+		// Only the low byte reaches bit 0; the high bits must not matter.
+		w := uint16(i) | 0xa500
+		if got := fold16(w); int(got) != n%2 {
+			panic(fmt.Sprintf("fold16(%#x) = %d, want %d", w, got, n%2))
+		}
+		v := uint32(i)<<8 | 0xff0000ff
+		if got := even8(v); got != (n%2 == 0) {
+			panic(fmt.Sprintf("even8(%#x) = %v, want %v", v, got, n%2 == 0))
+		}
+	}
+	for _, x := range []uint64{0, 1, 0x100, 0x8000000000000000, 0xffffffffffffffff, 0x123456789abcdef0, 0x0101010101010100} {
+		if got, want := fold64(x), uint64(slowCount(x)%2); got != want {
+			panic(fmt.Sprintf("fold64(%#x) = %d, want %d", x, got, want))
+		}
+	}
+}
@@ -7,7 +7,10 @@
 
 package main
 
-import "math"
+import (
+	"math"
+	"math/bits"
+)
 
 func f0(a []int) int {
 	a[0] = 1
@@ -560,6 +563,49 @@ func fence4(x, y int64) {
 	}
 }
 
+func ctz1(x uint64) int {
+	for x != 0 {
+		useInt(bits.TrailingZeros64(x)) // ERROR "Proved Ctz64 non-zero$"
+		x &= x - 1
+	}
+	return bits.TrailingZeros64(x)
+}
+
+func ctz2(x int32) int {
+	if x < 0 {
+		return bits.TrailingZeros32(uint32(x)) // ERROR "Proved Ctz32 non-zero$"
+	}
+	return bits.TrailingZeros32(uint32(x))
+}
+
+func ctz3(x uint64) int {
+	if x > 0 {
+		return bits.TrailingZeros64(x) // ERROR "Proved Ctz64 non-zero$"
+	}
+	return 64
+}
+
+func clamp1(a *[16]int, i uint) int {
+	if i >= 16 {
+		i = 15
+	}
+	return a[i] // ERROR "Proved IsInBounds$"
+}
+
+func clamp2(a []int, i uint) int {
+	if i >= uint(len(a)) {
+		i = uint(len(a)) - 1
+	}
+	return a[i] // a may be empty
+}
+
+func clamp3(a *[16]int, i int) int {
+	if i >= 16 {
+		i = 15
+	}
+	return a[i] // i may be negative
+}
+
 //go:noinline
 func useInt(a int) {
 }
@@ -471,6 +471,36 @@ func testcalls() {
 	}
 }
 
+// test that filling a slice with a constant byte,
+// which is done 8 bytes at a time, sets every byte
+// of the slice and no other, and leaves the index
+// at the last element.
+
+func testfill() {
+	for n := 0; n < 20; n++ {
+		b := make([]byte, n+2)
+		s := b[1 : n+1]
+		i := -1
+		for i = range s {
+			s[i] = 0x5a
+		}
+		if n > 0 && i != n-1 || n == 0 && i != -1 {
+			println("wrong index after filling", n, "bytes:", i)
+			panic("fail")
+		}
+		if b[0] != 0 || b[n+1] != 0 {
+			println("filling", n, "bytes wrote outside the slice")
+			panic("fail")
+		}
+		for _, c := range s {
+			if c != 0x5a {
+				println("filling", n, "bytes left", c)
+				panic("fail")
+			}
+		}
+	}
+}
+
 func main() {
 	testblankvars()
 	testchan()
@@ -491,4 +521,5 @@ func main() {
 	testmap1()
 	testmap2()
 	testcalls()
+	testfill()
 }
@@ -0,0 +1,84 @@
+// run
+
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Test saturating increments, which some architectures
+// compile to a conditional increment.
+
+package main
+
+import "fmt"
+
+//go:noinline
+func inc32(x uint32) uint32 {
+	if x != 1<<32-1 {
+		x++
+	}
+	return x
+}
+
+//go:noinline
+func inc64(x uint64) uint64 {
+	if x != 1<<64-1 {
+		x++
+	}
+	return x
+}
+
+//go:noinline
+func incTo(x, max int) int {
+	if x != max {
+		x++
+	}
+	return x
+}
+
+//go:noinline
+func inc8(x uint8) uint8 {
+	if x < 255 {
+		x++
+	}
+	return x
+}
+
+//go:noinline
+func incNonzero(x int) int {
+	if x == 0 {
+		return x
+	}
+	return x + 1
+}
+
+func main() {
+	for _, c := range []struct{ x, want uint32 }{{0, 1}, {7, 8}, {1<<32 - 2, 1<<32 - 1}, {1<<32 - 1, 1<<32 - 1}} {
+		if got := inc32(c.x); got != c.want {
+			panic(fmt.Sprintf("inc32(%#x) = %#x, want %#x", c.x, got, c.want))
+		}
+	}
+	for _, c := range []struct{ x, want uint64 }{{0, 1}, {1<<64 - 2, 1<<64 - 1}, {1<<64 - 1, 1<<64 - 1}} {
+		if got := inc64(c.x); got != c.want {
+			panic(fmt.Sprintf("inc64(%#x) = %#x, want %#x", c.x, got, c.want))
+		}
+	}
+	for _, c := range []struct{ x, max, want int }{{0, 10, 1}, {10, 10, 10}, {-1, 0, 0}, {0, 0, 0}, {-1 << 63, -1<<63 + 1, -1<<63 + 1}} {
+		if got := incTo(c.x, c.max); got != c.want {
+			panic(fmt.Sprintf("incTo(%d, %d) = %d, want %d", c.x, c.max, got, c.want))
+		}
+	}
+	for x := 0; x < 256; x++ {
+		want := uint8(x) + 1
+		if x == 255 {
+			want = 255
+		}
+		if got := inc8(uint8(x)); got != want {
+			panic(fmt.Sprintf("inc8(%d) = %d, want %d", x, got, want))
+		}
+	}
+	for _, c := range []struct{ x, want int }{{0, 0}, {1, 2}, {-1, 0}} {
+		if got := incNonzero(c.x); got != c.want {
+			panic(fmt.Sprintf("incNonzero(%d) = %d, want %d", c.x, got, c.want))
+		}
+	}
+}
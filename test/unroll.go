@@ -0,0 +1,76 @@
+// run
+
+// Copyright 2018 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Test that loops with a small constant trip count,
+// which the compiler unrolls on arm64, keep their meaning.
+
+package main
+
+import "fmt"
+
+//go:noinline
+func gather(dst *[4]int, src []int, stride int) {
+	for i := 0; i < 4; i++ {
+		dst[i] = src[i*stride]
+	}
+}
+
+//go:noinline
+func last(a []int) int {
+	var i int
+	for i = 1; i < 3; i++ {
+		a[i] += a[i-1]
+	}
+	return i
+}
+
+//go:noinline
+func gatherPanics(src []int) (dst [4]int, ok bool) {
+	defer func() {
+		recover()
+	}()
+	for i := 0; i < 4; i++ {
+		dst[i] = src[i*2]
+	}
+	return dst, true
+}
+
+//go:noinline
+func byte4(b []byte) uint32 {
+	var x uint32
+	for i := uint(0); i < 4; i++ {
+		x |= uint32(b[i]) << (8 * i)
+	}
+	return x
+}
+
+func main() {
+	src := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	var dst [4]int
+	gather(&dst, src, 3)
+	if dst != [4]int{0, 3, 6, 9} {
+		panic(fmt.Sprintf("gather = %v", dst))
+	}
+
+	a := []int{1, 2, 3, 4}
+	if i := last(a); i != 3 {
+		panic(fmt.Sprintf("last index = %d, want 3", i))
+	}
+	if fmt.Sprint(a) != "[1 3 6 4]" {
+		panic(fmt.Sprintf("last = %v", a))
+	}
+
+	// The store of the element before the out of range one
+	// must still happen.
+	dst, ok := gatherPanics(src[:5])
+	if ok || dst != [4]int{0, 2, 4, 0} {
+		panic(fmt.Sprintf("gatherPanics = %v, %v", dst, ok))
+	}
+
+	if x := byte4([]byte{1, 2, 3, 4}); x != 0x04030201 {
+		panic(fmt.Sprintf("byte4 = %#x", x))
+	}
+}